@@ -4,28 +4,48 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
 	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"runtime"
 	"sort"
+	"strconv"
 
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
 	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
 	translatorpb "github.com/alchematik/athanor-go/internal/gen/go/proto/translator/v1"
 	"github.com/alchematik/athanor-go/internal/generate/consumer"
 	"github.com/alchematik/athanor-go/internal/generate/provider"
 
-	wasmtime "github.com/bytecodealliance/wasmtime-go/v19"
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// envMaxBuilds limits the number of go builds the translator runs at once.
+	// It defaults to the number of CPUs.
+	envMaxBuilds = "ATHANOR_GO_MAX_BUILDS"
+
+	// The debug dir keeps the artifacts of each translation (config, output,
+	// main.wasm and a record of the go build) instead of removing them. It can
+	// be set for all requests through the environment or per request in args.
+	envDebugDir = "ATHANOR_GO_DEBUG_DIR"
+	argDebugDir = "debug_dir"
+)
+
 func main() {
+	maxBuilds := runtime.NumCPU()
+	if v := os.Getenv(envMaxBuilds); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("invalid %s: %v", envMaxBuilds, err)
+		}
+
+		maxBuilds = n
+	}
+
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: plugin.HandshakeConfig{
 			ProtocolVersion:  1,
@@ -34,7 +54,11 @@ func main() {
 		},
 		Plugins: map[string]plugin.Plugin{
 			"translator": &Plugin{
-				TranslatorServer: &Server{},
+				TranslatorServer: &Server{
+					toolchain: toolchainFromEnv(),
+					builder:   newBuilder(maxBuilds),
+					debugDir:  os.Getenv(envDebugDir),
+				},
 			},
 		},
 		GRPCServer: plugin.DefaultGRPCServer,
@@ -57,152 +81,103 @@ func (p *Plugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, conn *grpc.
 }
 
 type Server struct {
+	toolchain toolchain
+	builder   *builder
+	debugDir  string
 }
 
-func (s *Server) TranslateProviderSchema(ctx context.Context, req *translatorpb.TranslateProviderSchemaRequest) (*translatorpb.TranslateProviderSchemaResponse, error) {
-	if err := exec.Command("go", "run", req.GetInputPath(), req.GetOutputPath()).Run(); err != nil {
-		return &translatorpb.TranslateProviderSchemaResponse{}, status.Error(codes.Internal, err.Error())
+func (s *Server) debugDirFromArgs(args map[string]string) string {
+	if v, ok := args[argDebugDir]; ok {
+		return v
 	}
 
-	return &translatorpb.TranslateProviderSchemaResponse{}, nil
+	return s.debugDir
 }
 
-func (s *Server) TranslateBlueprint(ctx context.Context, req *translatorpb.TranslateBlueprintRequest) (*translatorpb.TranslateBlueprintResponse, error) {
-	inputPath := req.GetInputPath()
-	outputPath := req.GetOutputPath()
-	configPath := req.GetConfigPath()
-
-	buildDir, err := os.MkdirTemp("", "translator")
-	if err != nil {
-		log.Printf("FAILED TO create temp dir >> %v\n", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
-	}
-
-	buildPath := filepath.Join(buildDir, "main.wasm")
-	//defer os.RemoveAll(buildDir)
-
-	configSource, err := os.Open(configPath)
+func (s *Server) TranslateProviderSchema(ctx context.Context, req *translatorpb.TranslateProviderSchemaRequest) (*translatorpb.TranslateProviderSchemaResponse, error) {
+	tc := s.toolchain.withArgs(req.GetArgs())
+	version, err := tc.version()
 	if err != nil {
-		log.Printf("failed to open config source >> %v\n", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
+		log.Printf("failed to get toolchain version: %v", err)
+		return &translatorpb.TranslateProviderSchemaResponse{}, status.Error(codes.Internal, err.Error())
 	}
-	defer configSource.Close()
 
-	buildConfigPath := filepath.Join(buildDir, "config")
-	buildConfigFile, err := os.Create(buildConfigPath)
+	data, err := s.builder.run(tc, program{
+		inputPath: req.GetInputPath(),
+		argv:      []string{"schema", sandboxOutputFile},
+		debugDir:  s.debugDirFromArgs(req.GetArgs()),
+	})
 	if err != nil {
-		log.Printf("failed to create build config file >> %v\n", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
-	}
-
-	if _, err := io.Copy(buildConfigFile, configSource); err != nil {
-		log.Printf("failed to copy config file >> %v\n", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
+		log.Printf("failed to translate schema: %v", err)
+		return &translatorpb.TranslateProviderSchemaResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	if err := buildConfigFile.Close(); err != nil {
-		log.Printf("failed to close config file >> %v\n", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
+	var schema providerpb.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return &translatorpb.TranslateProviderSchemaResponse{}, status.Error(codes.Internal, "error parsing schema output: "+err.Error())
 	}
 
-	cmd := exec.Command("go", "build", "-o", buildPath, inputPath)
-	cmd.Env = append(cmd.Environ(), "GOOS=wasip1", "GOARCH=wasm")
-
-	log.Printf("BUILD PATH >>> %v\n", buildPath)
-	log.Printf("INPUT PATH >>> %v\n", inputPath)
-
-	// cmd := exec.Command("go", "run", inputPath, configPath, outputPath)
-	logger := log.Default()
-	cmd.Stdout = logger.Writer()
-	cmd.Stderr = logger.Writer()
-
-	if err := cmd.Run(); err != nil {
-		log.Printf("FAILED TO BUILD WASM>> %v\n", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
+	if err := writeOutput(req.GetOutputPath(), data); err != nil {
+		return &translatorpb.TranslateProviderSchemaResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	engine := wasmtime.NewEngine()
-	// cfg := wasmtime.NewConfig()
-	// wasmtime.NewEngineWithConfig(cfg)
-
-	// module, err := wasmtime.NewModuleFromFile(engine, "./sub/main.wasm")
-	module, err := wasmtime.NewModuleFromFile(engine, buildPath)
-	if err != nil {
-		log.Printf("failed to create module: %v", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
-	}
+	return &translatorpb.TranslateProviderSchemaResponse{
+		Schema:           &schema,
+		ToolchainVersion: version,
+	}, nil
+}
 
-	// Create a linker with WASI functions defined within it
-	linker := wasmtime.NewLinker(engine)
-	err = linker.DefineWasi()
+func (s *Server) TranslateBlueprint(ctx context.Context, req *translatorpb.TranslateBlueprintRequest) (*translatorpb.TranslateBlueprintResponse, error) {
+	config, err := os.ReadFile(req.GetConfigPath())
 	if err != nil {
-		log.Printf("failed to link: %v", err)
+		log.Printf("failed to read config: %v", err)
 		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	buildOutputPath := filepath.Join(buildDir, "output")
-	f, err := os.Create(buildOutputPath)
+	tc := s.toolchain.withArgs(req.GetArgs())
+	version, err := tc.version()
 	if err != nil {
-		log.Printf("failed to create output file: %v", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
-	}
-	if err := f.Close(); err != nil {
-		log.Printf("failed to close output file: %v", err)
+		log.Printf("failed to get toolchain version: %v", err)
 		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	wasiConfig := wasmtime.NewWasiConfig()
-	wasiConfig.InheritStderr()
-	wasiConfig.InheritStdout()
-	wasiConfig.SetArgv([]string{"config", "output"})
-
-	if err := wasiConfig.PreopenDir(buildDir, "/"); err != nil {
-		log.Printf("FAILED TO PREOPEN DIR: %v", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
-	}
-
-	store := wasmtime.NewStore(engine)
-	store.SetWasi(wasiConfig)
-	instance, err := linker.Instantiate(store, module)
+	data, err := s.builder.run(tc, program{
+		inputPath: req.GetInputPath(),
+		argv:      []string{"config", sandboxOutputFile},
+		inputs:    map[string][]byte{"config": config},
+		debugDir:  s.debugDirFromArgs(req.GetArgs()),
+	})
 	if err != nil {
-		log.Printf("failed to instantiate: %v", err)
+		log.Printf("failed to translate blueprint: %v", err)
 		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	nom := instance.GetFunc(store, "_start")
-	_, err = nom.Call(store)
-	if err != nil {
-		// log.Fatal("failed to call _start", err)
-		var wasmtimeError *wasmtime.Error
-		if errors.As(err, &wasmtimeError) {
-			st, ok := wasmtimeError.ExitStatus()
-			fmt.Printf("err >>> %v, %v\n", st, ok)
-		} else {
-			fmt.Printf("unknown error: %v\n", err)
-		}
+	var bp blueprintpb.Blueprint
+	if err := json.Unmarshal(data, &bp); err != nil {
+		log.Printf("failed to parse output: %v", err)
+		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, "error parsing blueprint output: "+err.Error())
 	}
 
-	outputFile, err := os.Open(buildOutputPath)
-	if err != nil {
-		log.Printf("failed to open output file: %v", err)
+	if err := writeOutput(req.GetOutputPath(), data); err != nil {
+		log.Printf("failed to write output: %v", err)
 		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
 	}
-	defer outputFile.Close()
 
-	log.Printf("OUTPUT FILE >> %v", outputPath)
-	outputDestFile, err := os.OpenFile(outputPath, os.O_APPEND|os.O_WRONLY, os.ModeAppend)
-	if err != nil {
-		log.Printf("failed to open output dest file: %v", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
-	}
-	defer outputDestFile.Close()
+	return &translatorpb.TranslateBlueprintResponse{
+		Blueprint:        &bp,
+		ToolchainVersion: version,
+	}, nil
+}
 
-	if _, err := io.Copy(outputDestFile, outputFile); err != nil {
-		log.Printf("failed to copy output: %v", err)
-		return &translatorpb.TranslateBlueprintResponse{}, status.Error(codes.Internal, err.Error())
+// writeOutput writes data to path, replacing any previous contents. Callers that
+// read the result from the response leave the path empty, in which case nothing
+// is written.
+func writeOutput(path string, data []byte) error {
+	if path == "" {
+		return nil
 	}
 
-	return &translatorpb.TranslateBlueprintResponse{}, nil
+	return os.WriteFile(path, data, 0666)
 }
 
 func (s *Server) GenerateProviderSDK(ctx context.Context, req *translatorpb.GenerateProviderSDKRequest) (*translatorpb.GenerateProvierSDKResponse, error) {
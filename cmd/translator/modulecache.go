@@ -0,0 +1,72 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+
+	wasmtime "github.com/bytecodealliance/wasmtime-go/v19"
+)
+
+// maxCachedModules bounds the number of compiled modules kept by a builder, so
+// that a long-running translator that builds many blueprint revisions doesn't
+// grow without bound.
+const maxCachedModules = 16
+
+// moduleCache is a least recently used cache of compiled modules, keyed by the
+// SHA-256 of their wasm. It is not safe for concurrent use.
+type moduleCache struct {
+	max int
+	// order holds the cached entries, most recently used first.
+	order   *list.List
+	entries map[[sha256.Size]byte]*list.Element
+}
+
+type moduleEntry struct {
+	sum    [sha256.Size]byte
+	module *wasmtime.Module
+}
+
+func newModuleCache(max int) *moduleCache {
+	if max < 1 {
+		max = 1
+	}
+
+	return &moduleCache{
+		max:     max,
+		order:   list.New(),
+		entries: map[[sha256.Size]byte]*list.Element{},
+	}
+}
+
+func (c *moduleCache) get(sum [sha256.Size]byte) (*wasmtime.Module, bool) {
+	el, ok := c.entries[sum]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+
+	return el.Value.(*moduleEntry).module, true
+}
+
+// add caches module under sum, evicting the least recently used module if the
+// cache is full.
+func (c *moduleCache) add(sum [sha256.Size]byte, module *wasmtime.Module) {
+	if el, ok := c.entries[sum]; ok {
+		el.Value.(*moduleEntry).module = module
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[sum] = c.order.PushFront(&moduleEntry{sum: sum, module: module})
+
+	for c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*moduleEntry).sum)
+	}
+}
+
+func (c *moduleCache) len() int {
+	return c.order.Len()
+}
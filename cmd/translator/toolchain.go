@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Request args and environment variables used to configure the Go toolchain.
+// Request args take precedence over the translator's environment.
+const (
+	argGo         = "go"
+	argGoModCache = "gomodcache"
+	argGoFlags    = "goflags"
+	argGoProxy    = "goproxy"
+
+	envGo         = "ATHANOR_GO"
+	envGoModCache = "ATHANOR_GO_GOMODCACHE"
+	envGoFlags    = "ATHANOR_GO_GOFLAGS"
+	envGoProxy    = "ATHANOR_GO_GOPROXY"
+)
+
+// toolchain describes the Go toolchain used to build blueprint and schema
+// programs. Empty fields select the go command's defaults rather than the
+// translator's environment, so the same request always builds the same way.
+type toolchain struct {
+	Go         string
+	GoModCache string
+	GoFlags    string
+	GoProxy    string
+}
+
+func toolchainFromEnv() toolchain {
+	return toolchain{
+		Go:         os.Getenv(envGo),
+		GoModCache: os.Getenv(envGoModCache),
+		GoFlags:    os.Getenv(envGoFlags),
+		GoProxy:    os.Getenv(envGoProxy),
+	}
+}
+
+func (t toolchain) withArgs(args map[string]string) toolchain {
+	if v, ok := args[argGo]; ok {
+		t.Go = v
+	}
+
+	if v, ok := args[argGoModCache]; ok {
+		t.GoModCache = v
+	}
+
+	if v, ok := args[argGoFlags]; ok {
+		t.GoFlags = v
+	}
+
+	if v, ok := args[argGoProxy]; ok {
+		t.GoProxy = v
+	}
+
+	return t
+}
+
+// command returns a go command that runs with the configured toolchain
+// settings. The settings are always set, even when empty, so the translator's
+// environment and go env file can't change how programs are built, and
+// GOTOOLCHAIN=local keeps the go command from switching to a different,
+// possibly downloaded, version.
+func (t toolchain) command(args ...string) *exec.Cmd {
+	goBin := t.Go
+	if goBin == "" {
+		goBin = "go"
+	}
+
+	cmd := exec.Command(goBin, args...)
+	cmd.Env = append(
+		cmd.Environ(),
+		"GOTOOLCHAIN=local",
+		"GOENV=off",
+		"GOWORK=off",
+		"GOMODCACHE="+t.GoModCache,
+		"GOFLAGS="+t.GoFlags,
+		"GOPROXY="+t.GoProxy,
+	)
+
+	return cmd
+}
+
+// version reports the version of the configured go command, e.g. "go1.22.1".
+func (t toolchain) version() (string, error) {
+	cmd := t.command("env", "GOVERSION")
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("error getting go version: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToolchainCommandEnv(t *testing.T) {
+	t.Setenv("GOFLAGS", "-mod=mod")
+	t.Setenv("GOPROXY", "https://proxy.example.com")
+
+	tests := []struct {
+		name string
+		tc   toolchain
+		want []string
+	}{
+		{
+			name: "defaults",
+			tc:   toolchain{},
+			want: []string{"GOTOOLCHAIN=local", "GOENV=off", "GOWORK=off", "GOMODCACHE=", "GOFLAGS=", "GOPROXY="},
+		},
+		{
+			name: "configured",
+			tc:   toolchain{Go: "/opt/go/bin/go", GoModCache: "/cache", GoFlags: "-trimpath", GoProxy: "off"},
+			want: []string{"GOTOOLCHAIN=local", "GOENV=off", "GOWORK=off", "GOMODCACHE=/cache", "GOFLAGS=-trimpath", "GOPROXY=off"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := tt.tc.command("version").Env
+			for _, kv := range tt.want {
+				// Later entries take precedence, so the configured value must be
+				// the last one for its variable.
+				if got := lastEnv(env, kv); got != kv {
+					t.Errorf("got %q, want %q", got, kv)
+				}
+			}
+		})
+	}
+}
+
+func lastEnv(env []string, kv string) string {
+	key, _, _ := strings.Cut(kv, "=")
+	for i := len(env) - 1; i >= 0; i-- {
+		if strings.HasPrefix(env[i], key+"=") {
+			return env[i]
+		}
+	}
+
+	return ""
+}
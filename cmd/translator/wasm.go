@@ -0,0 +1,345 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	wasmtime "github.com/bytecodealliance/wasmtime-go/v19"
+)
+
+const sandboxOutputFile = "output"
+
+// builder compiles Go programs to wasip1 and runs them under wasmtime. A single
+// engine and a bounded module cache are shared across requests, the number of
+// concurrent go builds is bounded, and concurrent builds of the same input are
+// collapsed into one.
+type builder struct {
+	engine *wasmtime.Engine
+	sem    chan struct{}
+	// buildWasm compiles a program to wasm. Tests replace it to run without a
+	// Go toolchain.
+	buildWasm func(tc toolchain, inputPath, outputPath string, record bool) ([]byte, error)
+
+	mu       sync.Mutex
+	inflight map[buildKey]*buildCall
+	modules  *moduleCache
+}
+
+type buildKey struct {
+	toolchain toolchain
+	inputPath string
+}
+
+type buildCall struct {
+	done   chan struct{}
+	module *wasmtime.Module
+	err    error
+}
+
+func newBuilder(maxBuilds int) *builder {
+	if maxBuilds < 1 {
+		maxBuilds = 1
+	}
+
+	return &builder{
+		engine:    wasmtime.NewEngine(),
+		sem:       make(chan struct{}, maxBuilds),
+		buildWasm: buildWasm,
+		inflight:  map[buildKey]*buildCall{},
+		modules:   newModuleCache(maxCachedModules),
+	}
+}
+
+// program is a Go program to be compiled and run in the sandbox.
+type program struct {
+	inputPath string
+	argv      []string
+	// inputs are files made available to the program in the sandbox.
+	inputs map[string][]byte
+	// debugDir, if set, is a directory in which the build artifacts are kept
+	// instead of being removed once the program has run.
+	debugDir string
+}
+
+// run compiles p and runs it. The program only has access to a sandbox
+// directory, preopened as "/", that holds its input files and an empty output
+// file. The contents of the output file are returned once the program exits.
+func (b *builder) run(tc toolchain, p program) ([]byte, error) {
+	buildDir, err := b.buildDir(p.debugDir)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := b.runIn(tc, p, buildDir)
+	if err != nil && p.debugDir != "" {
+		return nil, fmt.Errorf("%v (artifacts kept in %s)", err, buildDir)
+	}
+
+	return data, err
+}
+
+// buildDir returns the directory that holds the artifacts of a single run. In
+// debug mode it is created in debugDir and left in place afterwards, otherwise
+// it is a temp dir that is removed once the run completes.
+func (b *builder) buildDir(debugDir string) (string, error) {
+	if debugDir == "" {
+		return os.MkdirTemp("", "translator")
+	}
+
+	if err := os.MkdirAll(debugDir, 0777); err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp(debugDir, time.Now().UTC().Format("20060102T150405")+"-")
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("keeping translator artifacts in %s", dir)
+
+	return dir, nil
+}
+
+func (b *builder) runIn(tc toolchain, p program, buildDir string) ([]byte, error) {
+	if p.debugDir == "" {
+		defer os.RemoveAll(buildDir)
+	}
+
+	sandboxDir := filepath.Join(buildDir, "sandbox")
+	if err := os.Mkdir(sandboxDir, 0777); err != nil {
+		return nil, err
+	}
+
+	for name, data := range p.inputs {
+		if err := os.WriteFile(filepath.Join(sandboxDir, name), data, 0666); err != nil {
+			return nil, err
+		}
+	}
+
+	outputPath := filepath.Join(sandboxDir, sandboxOutputFile)
+	if err := os.WriteFile(outputPath, nil, 0666); err != nil {
+		return nil, err
+	}
+
+	// Debug runs always build so that the module and build record end up in
+	// their own artifact directory.
+	var module *wasmtime.Module
+	var err error
+	if p.debugDir != "" {
+		module, err = b.buildModule(tc, p.inputPath, buildDir, true)
+	} else {
+		module, err = b.module(tc, p.inputPath, buildDir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.runWasm(module, sandboxDir, p.argv); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(outputPath)
+}
+
+// module returns the compiled module for the program at inputPath. If a build
+// of the same input with the same toolchain is already in progress, its result
+// is shared instead of starting another build.
+func (b *builder) module(tc toolchain, inputPath, buildDir string) (*wasmtime.Module, error) {
+	if abs, err := filepath.Abs(inputPath); err == nil {
+		inputPath = abs
+	}
+
+	key := buildKey{toolchain: tc, inputPath: inputPath}
+
+	b.mu.Lock()
+	if c, ok := b.inflight[key]; ok {
+		b.mu.Unlock()
+		<-c.done
+		return c.module, c.err
+	}
+
+	c := &buildCall{done: make(chan struct{})}
+	b.inflight[key] = c
+	b.mu.Unlock()
+
+	c.module, c.err = b.buildModule(tc, inputPath, buildDir, false)
+	close(c.done)
+
+	b.mu.Lock()
+	delete(b.inflight, key)
+	b.mu.Unlock()
+
+	return c.module, c.err
+}
+
+func (b *builder) buildModule(tc toolchain, inputPath, buildDir string, record bool) (*wasmtime.Module, error) {
+	b.sem <- struct{}{}
+	wasm, err := b.buildWasm(tc, inputPath, filepath.Join(buildDir, "main.wasm"), record)
+	<-b.sem
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(wasm)
+
+	b.mu.Lock()
+	module, ok := b.modules.get(sum)
+	b.mu.Unlock()
+	if ok {
+		return module, nil
+	}
+
+	module, err = wasmtime.NewModule(b.engine, wasm)
+	if err != nil {
+		return nil, fmt.Errorf("error loading module: %v", err)
+	}
+
+	b.mu.Lock()
+	b.modules.add(sum, module)
+	b.mu.Unlock()
+
+	return module, nil
+}
+
+// recordedEnv lists the variables kept in a buildRecord. They are the ones the
+// translator sets; the rest of the environment is inherited from the process
+// and may hold credentials.
+var recordedEnv = []string{"GOOS", "GOARCH", "GOTOOLCHAIN", "GOENV", "GOWORK", "GOMODCACHE", "GOFLAGS", "GOPROXY"}
+
+// buildRecord describes a go build invocation. In debug mode it is written
+// next to the built module so that a failed translation can be reproduced.
+type buildRecord struct {
+	Dir     string   `json:"dir"`
+	Command []string `json:"command"`
+	Env     []string `json:"env"`
+	Output  string   `json:"output"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// buildWasm compiles the Go program at inputPath for wasip1, writes the module
+// to outputPath and returns its contents. Compiler output is included in the
+// returned error. If record is set, the command, build environment and output
+// are recorded in build.json next to outputPath.
+func buildWasm(tc toolchain, inputPath, outputPath string, record bool) ([]byte, error) {
+	cmd := tc.command("build", "-o", outputPath, inputPath)
+	cmd.Env = append(cmd.Env, "GOOS=wasip1", "GOARCH=wasm")
+
+	var out bytes.Buffer
+	logger := log.Default()
+	cmd.Stdout = io.MultiWriter(logger.Writer(), &out)
+	cmd.Stderr = io.MultiWriter(logger.Writer(), &out)
+
+	runErr := cmd.Run()
+
+	if record {
+		dir := cmd.Dir
+		if dir == "" {
+			dir, _ = os.Getwd()
+		}
+
+		r := buildRecord{
+			Dir:     dir,
+			Command: cmd.Args,
+			Env:     buildEnv(cmd.Env),
+			Output:  out.String(),
+		}
+		if runErr != nil {
+			r.Error = runErr.Error()
+		}
+
+		if err := writeBuildRecord(filepath.Join(filepath.Dir(outputPath), "build.json"), r); err != nil {
+			return nil, err
+		}
+	}
+
+	if runErr != nil {
+		return nil, fmt.Errorf("error building %s: %v: %s", inputPath, runErr, strings.TrimSpace(out.String()))
+	}
+
+	return os.ReadFile(outputPath)
+}
+
+// buildEnv returns the effective value of each recordedEnv variable set in env,
+// in the order of recordedEnv.
+func buildEnv(env []string) []string {
+	values := map[string]string{}
+	for _, kv := range env {
+		key, _, _ := strings.Cut(kv, "=")
+		values[key] = kv
+	}
+
+	var recorded []string
+	for _, key := range recordedEnv {
+		if kv, ok := values[key]; ok {
+			recorded = append(recorded, kv)
+		}
+	}
+
+	return recorded
+}
+
+func writeBuildRecord(path string, record buildRecord) error {
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0666)
+}
+
+// runWasm runs module with sandboxDir preopened as "/". A non-zero exit status
+// is reported as an error.
+func (b *builder) runWasm(module *wasmtime.Module, sandboxDir string, argv []string) error {
+	linker := wasmtime.NewLinker(b.engine)
+	if err := linker.DefineWasi(); err != nil {
+		return fmt.Errorf("error linking wasi: %v", err)
+	}
+
+	wasiConfig := wasmtime.NewWasiConfig()
+	wasiConfig.InheritStderr()
+	wasiConfig.InheritStdout()
+	wasiConfig.SetArgv(argv)
+
+	if err := wasiConfig.PreopenDir(sandboxDir, "/"); err != nil {
+		return fmt.Errorf("error preopening sandbox: %v", err)
+	}
+
+	store := wasmtime.NewStore(b.engine)
+	store.SetWasi(wasiConfig)
+	instance, err := linker.Instantiate(store, module)
+	if err != nil {
+		return fmt.Errorf("error instantiating module: %v", err)
+	}
+
+	start := instance.GetFunc(store, "_start")
+	if start == nil {
+		return fmt.Errorf("module has no _start function")
+	}
+
+	if _, err := start.Call(store); err != nil {
+		var wasmtimeError *wasmtime.Error
+		if errors.As(err, &wasmtimeError) {
+			if st, ok := wasmtimeError.ExitStatus(); ok {
+				if st == 0 {
+					return nil
+				}
+
+				return fmt.Errorf("program exited with status %d", st)
+			}
+		}
+
+		return fmt.Errorf("error running program: %v", err)
+	}
+
+	return nil
+}
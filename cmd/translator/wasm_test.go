@@ -0,0 +1,168 @@
+package main
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	wasmtime "github.com/bytecodealliance/wasmtime-go/v19"
+)
+
+// testWasm returns a minimal valid module with a custom section named name, so
+// that different names produce different modules.
+func testWasm(name string) []byte {
+	wasm := []byte("\x00asm\x01\x00\x00\x00")
+	wasm = append(wasm, 0, byte(1+len(name)), byte(len(name)))
+	return append(wasm, name...)
+}
+
+func TestBuilderModuleDeduplicatesBuilds(t *testing.T) {
+	b := newBuilder(4)
+
+	var calls int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+	b.buildWasm = func(tc toolchain, inputPath, outputPath string, record bool) ([]byte, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+		}
+
+		<-release
+		return testWasm(inputPath), nil
+	}
+
+	const n = 8
+	modules := make([]*wasmtime.Module, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			modules[i], errs[i] = b.module(toolchain{}, "/src/main.go", t.TempDir())
+		}(i)
+	}
+
+	<-started
+	// Give the other callers time to find the build in flight.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got %d builds, want 1", calls)
+	}
+
+	for i := range modules {
+		if errs[i] != nil {
+			t.Fatalf("unexpected error: %v", errs[i])
+		}
+
+		if modules[i] != modules[0] {
+			t.Errorf("caller %d got a different module", i)
+		}
+	}
+}
+
+func TestBuilderLimitsConcurrentBuilds(t *testing.T) {
+	const maxBuilds = 2
+	b := newBuilder(maxBuilds)
+
+	var running, peak int32
+	b.buildWasm = func(tc toolchain, inputPath, outputPath string, record bool) ([]byte, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return testWasm(inputPath), nil
+	}
+
+	var wg sync.WaitGroup
+	for _, path := range []string{"/a", "/b", "/c", "/d", "/e", "/f"} {
+		wg.Add(1)
+		go func(path string) {
+			defer wg.Done()
+			if _, err := b.module(toolchain{}, path, t.TempDir()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(path)
+	}
+
+	wg.Wait()
+
+	if peak > maxBuilds {
+		t.Errorf("got %d concurrent builds, want at most %d", peak, maxBuilds)
+	}
+}
+
+func TestBuilderEvictsModules(t *testing.T) {
+	b := newBuilder(1)
+	b.modules = newModuleCache(2)
+	b.buildWasm = func(tc toolchain, inputPath, outputPath string, record bool) ([]byte, error) {
+		return testWasm(inputPath), nil
+	}
+
+	for _, path := range []string{"/a", "/b", "/a", "/c"} {
+		if _, err := b.module(toolchain{}, path, t.TempDir()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got := b.modules.len(); got != 2 {
+		t.Errorf("got %d cached modules, want 2", got)
+	}
+
+	// "/b" was the least recently used when "/c" was added.
+	for path, want := range map[string]bool{"/a": true, "/b": false, "/c": true} {
+		if _, ok := b.modules.get(sha256.Sum256(testWasm(path))); ok != want {
+			t.Errorf("got %s cached %t, want %t", path, ok, want)
+		}
+	}
+}
+
+func TestBuildEnv(t *testing.T) {
+	env := []string{
+		"HOME=/root",
+		"AWS_SECRET_ACCESS_KEY=secret",
+		"GOFLAGS=-mod=mod",
+		"GITHUB_TOKEN=token",
+		"GOTOOLCHAIN=local",
+		"GOFLAGS=",
+		"GOARCH=wasm",
+		"GOOS=wasip1",
+	}
+
+	got := strings.Join(buildEnv(env), " ")
+	want := "GOOS=wasip1 GOARCH=wasm GOTOOLCHAIN=local GOFLAGS="
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestBuildWasmRecordsOnlyInDebugMode(t *testing.T) {
+	tc := toolchain{Go: filepath.Join(t.TempDir(), "missing-go")}
+
+	for _, record := range []bool{false, true} {
+		dir := t.TempDir()
+		if _, err := buildWasm(tc, "main.go", filepath.Join(dir, "main.wasm"), record); err == nil {
+			t.Fatal("got no error building with a missing toolchain")
+		}
+
+		_, err := os.Stat(filepath.Join(dir, "build.json"))
+		if got := err == nil; got != record {
+			t.Errorf("record %t: got build.json written %t, want %t", record, got, record)
+		}
+	}
+}
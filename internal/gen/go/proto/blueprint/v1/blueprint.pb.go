@@ -76,7 +76,9 @@ type Stmt struct {
 	//
 	//	*Stmt_Resource
 	//	*Stmt_Build
-	Type isStmt_Type `protobuf_oneof:"type"`
+	//	*Stmt_Output
+	Type   isStmt_Type `protobuf_oneof:"type"`
+	Origin string      `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
 func (x *Stmt) Reset() {
@@ -132,6 +134,20 @@ func (x *Stmt) GetBuild() *BuildStmt {
 	return nil
 }
 
+func (x *Stmt) GetOutput() *OutputStmt {
+	if x, ok := x.GetType().(*Stmt_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *Stmt) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
 type isStmt_Type interface {
 	isStmt_Type()
 }
@@ -144,18 +160,25 @@ type Stmt_Build struct {
 	Build *BuildStmt `protobuf:"bytes,2,opt,name=build,proto3,oneof"`
 }
 
+type Stmt_Output struct {
+	Output *OutputStmt `protobuf:"bytes,4,opt,name=output,proto3,oneof"`
+}
+
 func (*Stmt_Resource) isStmt_Type() {}
 
 func (*Stmt_Build) isStmt_Type() {}
 
+func (*Stmt_Output) isStmt_Type() {}
+
 type ResourceStmt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Resource *ResourceExpr `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Provider *ProviderExpr `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Exists   *Expr         `protobuf:"bytes,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	Resource *ResourceExpr    `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Provider *ProviderExpr    `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Exists   *Expr            `protobuf:"bytes,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	Options  *ResourceOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
 }
 
 func (x *ResourceStmt) Reset() {
@@ -211,6 +234,139 @@ func (x *ResourceStmt) GetExists() *Expr {
 	return nil
 }
 
+func (x *ResourceStmt) GetOptions() *ResourceOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ResourceOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreventDestroy      bool     `protobuf:"varint,1,opt,name=prevent_destroy,json=preventDestroy,proto3" json:"prevent_destroy,omitempty"`
+	IgnoreChanges       []string `protobuf:"bytes,2,rep,name=ignore_changes,json=ignoreChanges,proto3" json:"ignore_changes,omitempty"`
+	CreateBeforeDestroy bool     `protobuf:"varint,3,opt,name=create_before_destroy,json=createBeforeDestroy,proto3" json:"create_before_destroy,omitempty"`
+	DependsOn           []string `protobuf:"bytes,4,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+}
+
+func (x *ResourceOptions) Reset() {
+	*x = ResourceOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceOptions) ProtoMessage() {}
+
+func (x *ResourceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceOptions.ProtoReflect.Descriptor instead.
+func (*ResourceOptions) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceOptions) GetPreventDestroy() bool {
+	if x != nil {
+		return x.PreventDestroy
+	}
+	return false
+}
+
+func (x *ResourceOptions) GetIgnoreChanges() []string {
+	if x != nil {
+		return x.IgnoreChanges
+	}
+	return nil
+}
+
+func (x *ResourceOptions) GetCreateBeforeDestroy() bool {
+	if x != nil {
+		return x.CreateBeforeDestroy
+	}
+	return false
+}
+
+func (x *ResourceOptions) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+type OutputStmt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value *Expr  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OutputStmt) Reset() {
+	*x = OutputStmt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputStmt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputStmt) ProtoMessage() {}
+
+func (x *OutputStmt) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputStmt.ProtoReflect.Descriptor instead.
+func (*OutputStmt) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OutputStmt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutputStmt) GetValue() *Expr {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type BuildStmt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,7 +379,7 @@ type BuildStmt struct {
 func (x *BuildStmt) Reset() {
 	*x = BuildStmt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[3]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -236,7 +392,7 @@ func (x *BuildStmt) String() string {
 func (*BuildStmt) ProtoMessage() {}
 
 func (x *BuildStmt) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[3]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +405,7 @@ func (x *BuildStmt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildStmt.ProtoReflect.Descriptor instead.
 func (*BuildStmt) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{3}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BuildStmt) GetTranslator() *Translator {
@@ -287,13 +443,16 @@ type Expr struct {
 	//	*Expr_File
 	//	*Expr_GetRuntimeConfig
 	//	*Expr_Build
+	//	*Expr_Concat
+	//	*Expr_Function
+	//	*Expr_Secret
 	Type isExpr_Type `protobuf_oneof:"type"`
 }
 
 func (x *Expr) Reset() {
 	*x = Expr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[4]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -306,7 +465,7 @@ func (x *Expr) String() string {
 func (*Expr) ProtoMessage() {}
 
 func (x *Expr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[4]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +478,7 @@ func (x *Expr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expr.ProtoReflect.Descriptor instead.
 func (*Expr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{4}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *Expr) GetType() isExpr_Type {
@@ -427,6 +586,27 @@ func (x *Expr) GetBuild() *BuildExpr {
 	return nil
 }
 
+func (x *Expr) GetConcat() *ConcatExpr {
+	if x, ok := x.GetType().(*Expr_Concat); ok {
+		return x.Concat
+	}
+	return nil
+}
+
+func (x *Expr) GetFunction() *FunctionExpr {
+	if x, ok := x.GetType().(*Expr_Function); ok {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *Expr) GetSecret() *SecretExpr {
+	if x, ok := x.GetType().(*Expr_Secret); ok {
+		return x.Secret
+	}
+	return nil
+}
+
 type isExpr_Type interface {
 	isExpr_Type()
 }
@@ -487,6 +667,18 @@ type Expr_Build struct {
 	Build *BuildExpr `protobuf:"bytes,14,opt,name=build,proto3,oneof"`
 }
 
+type Expr_Concat struct {
+	Concat *ConcatExpr `protobuf:"bytes,15,opt,name=concat,proto3,oneof"`
+}
+
+type Expr_Function struct {
+	Function *FunctionExpr `protobuf:"bytes,16,opt,name=function,proto3,oneof"`
+}
+
+type Expr_Secret struct {
+	Secret *SecretExpr `protobuf:"bytes,17,opt,name=secret,proto3,oneof"`
+}
+
 func (*Expr_StringLiteral) isExpr_Type() {}
 
 func (*Expr_IntLiteral) isExpr_Type() {}
@@ -515,31 +707,41 @@ func (*Expr_GetRuntimeConfig) isExpr_Type() {}
 
 func (*Expr_Build) isExpr_Type() {}
 
-type BlueprintExpr struct {
+func (*Expr_Concat) isExpr_Type() {}
+
+func (*Expr_Function) isExpr_Type() {}
+
+func (*Expr_Secret) isExpr_Type() {}
+
+type SecretExpr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stmts []*Stmt `protobuf:"bytes,1,rep,name=stmts,proto3" json:"stmts,omitempty"`
+	// Types that are assignable to Source:
+	//
+	//	*SecretExpr_Env
+	//	*SecretExpr_File
+	Source isSecretExpr_Source `protobuf_oneof:"source"`
 }
 
-func (x *BlueprintExpr) Reset() {
-	*x = BlueprintExpr{}
+func (x *SecretExpr) Reset() {
+	*x = SecretExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[5]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlueprintExpr) String() string {
+func (x *SecretExpr) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlueprintExpr) ProtoMessage() {}
+func (*SecretExpr) ProtoMessage() {}
 
-func (x *BlueprintExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[5]
+func (x *SecretExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,43 +752,73 @@ func (x *BlueprintExpr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlueprintExpr.ProtoReflect.Descriptor instead.
-func (*BlueprintExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SecretExpr.ProtoReflect.Descriptor instead.
+func (*SecretExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BlueprintExpr) GetStmts() []*Stmt {
-	if x != nil {
-		return x.Stmts
+func (m *SecretExpr) GetSource() isSecretExpr_Source {
+	if m != nil {
+		return m.Source
 	}
 	return nil
 }
 
-type ListExpr struct {
+func (x *SecretExpr) GetEnv() string {
+	if x, ok := x.GetSource().(*SecretExpr_Env); ok {
+		return x.Env
+	}
+	return ""
+}
+
+func (x *SecretExpr) GetFile() string {
+	if x, ok := x.GetSource().(*SecretExpr_File); ok {
+		return x.File
+	}
+	return ""
+}
+
+type isSecretExpr_Source interface {
+	isSecretExpr_Source()
+}
+
+type SecretExpr_Env struct {
+	Env string `protobuf:"bytes,1,opt,name=env,proto3,oneof"`
+}
+
+type SecretExpr_File struct {
+	File string `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+func (*SecretExpr_Env) isSecretExpr_Source() {}
+
+func (*SecretExpr_File) isSecretExpr_Source() {}
+
+type ConcatExpr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Elements []*Expr `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	Parts []*Expr `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
 }
 
-func (x *ListExpr) Reset() {
-	*x = ListExpr{}
+func (x *ConcatExpr) Reset() {
+	*x = ConcatExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[6]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListExpr) String() string {
+func (x *ConcatExpr) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpr) ProtoMessage() {}
+func (*ConcatExpr) ProtoMessage() {}
 
-func (x *ListExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[6]
+func (x *ConcatExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,43 +829,44 @@ func (x *ListExpr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpr.ProtoReflect.Descriptor instead.
-func (*ListExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ConcatExpr.ProtoReflect.Descriptor instead.
+func (*ConcatExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListExpr) GetElements() []*Expr {
+func (x *ConcatExpr) GetParts() []*Expr {
 	if x != nil {
-		return x.Elements
+		return x.Parts
 	}
 	return nil
 }
 
-type MapExpr struct {
+type FunctionExpr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries map[string]*Expr `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args []*Expr `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 }
 
-func (x *MapExpr) Reset() {
-	*x = MapExpr{}
+func (x *FunctionExpr) Reset() {
+	*x = FunctionExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[7]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MapExpr) String() string {
+func (x *FunctionExpr) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapExpr) ProtoMessage() {}
+func (*FunctionExpr) ProtoMessage() {}
 
-func (x *MapExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[7]
+func (x *FunctionExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,43 +877,50 @@ func (x *MapExpr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapExpr.ProtoReflect.Descriptor instead.
-func (*MapExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use FunctionExpr.ProtoReflect.Descriptor instead.
+func (*FunctionExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MapExpr) GetEntries() map[string]*Expr {
+func (x *FunctionExpr) GetName() string {
 	if x != nil {
-		return x.Entries
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FunctionExpr) GetArgs() []*Expr {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-type FileExpr struct {
+type BlueprintExpr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Stmts []*Stmt `protobuf:"bytes,1,rep,name=stmts,proto3" json:"stmts,omitempty"`
 }
 
-func (x *FileExpr) Reset() {
-	*x = FileExpr{}
+func (x *BlueprintExpr) Reset() {
+	*x = BlueprintExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[8]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileExpr) String() string {
+func (x *BlueprintExpr) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileExpr) ProtoMessage() {}
+func (*BlueprintExpr) ProtoMessage() {}
 
-func (x *FileExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[8]
+func (x *BlueprintExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,31 +931,172 @@ func (x *FileExpr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileExpr.ProtoReflect.Descriptor instead.
-func (*FileExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BlueprintExpr.ProtoReflect.Descriptor instead.
+func (*BlueprintExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FileExpr) GetPath() string {
+func (x *BlueprintExpr) GetStmts() []*Stmt {
 	if x != nil {
-		return x.Path
+		return x.Stmts
 	}
-	return ""
+	return nil
 }
 
-type GetExpr struct {
+type ListExpr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Object *Expr  `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Elements []*Expr `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (x *ListExpr) Reset() {
+	*x = ListExpr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpr) ProtoMessage() {}
+
+func (x *ListExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpr.ProtoReflect.Descriptor instead.
+func (*ListExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListExpr) GetElements() []*Expr {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+type MapExpr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries map[string]*Expr `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MapExpr) Reset() {
+	*x = MapExpr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapExpr) ProtoMessage() {}
+
+func (x *MapExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapExpr.ProtoReflect.Descriptor instead.
+func (*MapExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MapExpr) GetEntries() map[string]*Expr {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FileExpr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *FileExpr) Reset() {
+	*x = FileExpr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileExpr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileExpr) ProtoMessage() {}
+
+func (x *FileExpr) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileExpr.ProtoReflect.Descriptor instead.
+func (*FileExpr) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FileExpr) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetExpr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Object *Expr  `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
 }
 
 func (x *GetExpr) Reset() {
 	*x = GetExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[9]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +1109,7 @@ func (x *GetExpr) String() string {
 func (*GetExpr) ProtoMessage() {}
 
 func (x *GetExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[9]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +1122,7 @@ func (x *GetExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExpr.ProtoReflect.Descriptor instead.
 func (*GetExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{9}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetExpr) GetName() string {
@@ -767,7 +1148,7 @@ type GetRuntimeConfig struct {
 func (x *GetRuntimeConfig) Reset() {
 	*x = GetRuntimeConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[10]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +1161,7 @@ func (x *GetRuntimeConfig) String() string {
 func (*GetRuntimeConfig) ProtoMessage() {}
 
 func (x *GetRuntimeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[10]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +1174,7 @@ func (x *GetRuntimeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuntimeConfig.ProtoReflect.Descriptor instead.
 func (*GetRuntimeConfig) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{10}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{15}
 }
 
 type NilExpr struct {
@@ -805,7 +1186,7 @@ type NilExpr struct {
 func (x *NilExpr) Reset() {
 	*x = NilExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[11]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -818,7 +1199,7 @@ func (x *NilExpr) String() string {
 func (*NilExpr) ProtoMessage() {}
 
 func (x *NilExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[11]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1212,7 @@ func (x *NilExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NilExpr.ProtoReflect.Descriptor instead.
 func (*NilExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{11}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{16}
 }
 
 type ProviderExpr struct {
@@ -841,12 +1222,14 @@ type ProviderExpr struct {
 
 	Name   string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Source *PluginSource `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Config *Expr         `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Alias  string        `protobuf:"bytes,5,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
 func (x *ProviderExpr) Reset() {
 	*x = ProviderExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[12]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -859,7 +1242,7 @@ func (x *ProviderExpr) String() string {
 func (*ProviderExpr) ProtoMessage() {}
 
 func (x *ProviderExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[12]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1255,7 @@ func (x *ProviderExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderExpr.ProtoReflect.Descriptor instead.
 func (*ProviderExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{12}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ProviderExpr) GetName() string {
@@ -889,6 +1272,20 @@ func (x *ProviderExpr) GetSource() *PluginSource {
 	return nil
 }
 
+func (x *ProviderExpr) GetConfig() *Expr {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ProviderExpr) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
 type ResourceExpr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -901,7 +1298,7 @@ type ResourceExpr struct {
 func (x *ResourceExpr) Reset() {
 	*x = ResourceExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[13]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1311,7 @@ func (x *ResourceExpr) String() string {
 func (*ResourceExpr) ProtoMessage() {}
 
 func (x *ResourceExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[13]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1324,7 @@ func (x *ResourceExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceExpr.ProtoReflect.Descriptor instead.
 func (*ResourceExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{13}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ResourceExpr) GetIdentifier() *Expr {
@@ -957,7 +1354,7 @@ type ResourceIdentifierExpr struct {
 func (x *ResourceIdentifierExpr) Reset() {
 	*x = ResourceIdentifierExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[14]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -970,7 +1367,7 @@ func (x *ResourceIdentifierExpr) String() string {
 func (*ResourceIdentifierExpr) ProtoMessage() {}
 
 func (x *ResourceIdentifierExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[14]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1380,7 @@ func (x *ResourceIdentifierExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceIdentifierExpr.ProtoReflect.Descriptor instead.
 func (*ResourceIdentifierExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{14}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ResourceIdentifierExpr) GetAlias() string {
@@ -1021,7 +1418,7 @@ type BuildExpr struct {
 func (x *BuildExpr) Reset() {
 	*x = BuildExpr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[15]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1034,7 +1431,7 @@ func (x *BuildExpr) String() string {
 func (*BuildExpr) ProtoMessage() {}
 
 func (x *BuildExpr) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[15]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1444,7 @@ func (x *BuildExpr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildExpr.ProtoReflect.Descriptor instead.
 func (*BuildExpr) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{15}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BuildExpr) GetAlias() string {
@@ -1090,7 +1487,7 @@ type Translator struct {
 func (x *Translator) Reset() {
 	*x = Translator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[16]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1103,7 +1500,7 @@ func (x *Translator) String() string {
 func (*Translator) ProtoMessage() {}
 
 func (x *Translator) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[16]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1513,7 @@ func (x *Translator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Translator.ProtoReflect.Descriptor instead.
 func (*Translator) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{16}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Translator) GetName() string {
@@ -1142,13 +1539,15 @@ type PluginSource struct {
 	//
 	//	*PluginSource_FilePath
 	//	*PluginSource_GitHubRelease
+	//	*PluginSource_Http
+	//	*PluginSource_Oci
 	Type isPluginSource_Type `protobuf_oneof:"type"`
 }
 
 func (x *PluginSource) Reset() {
 	*x = PluginSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[17]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1161,7 +1560,7 @@ func (x *PluginSource) String() string {
 func (*PluginSource) ProtoMessage() {}
 
 func (x *PluginSource) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[17]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,7 +1573,7 @@ func (x *PluginSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginSource.ProtoReflect.Descriptor instead.
 func (*PluginSource) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{17}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{22}
 }
 
 func (m *PluginSource) GetType() isPluginSource_Type {
@@ -1198,6 +1597,20 @@ func (x *PluginSource) GetGitHubRelease() *PluginSourceGitHubRelease {
 	return nil
 }
 
+func (x *PluginSource) GetHttp() *PluginSourceHTTP {
+	if x, ok := x.GetType().(*PluginSource_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *PluginSource) GetOci() *PluginSourceOCI {
+	if x, ok := x.GetType().(*PluginSource_Oci); ok {
+		return x.Oci
+	}
+	return nil
+}
+
 type isPluginSource_Type interface {
 	isPluginSource_Type()
 }
@@ -1210,10 +1623,22 @@ type PluginSource_GitHubRelease struct {
 	GitHubRelease *PluginSourceGitHubRelease `protobuf:"bytes,2,opt,name=git_hub_release,json=gitHubRelease,proto3,oneof"`
 }
 
+type PluginSource_Http struct {
+	Http *PluginSourceHTTP `protobuf:"bytes,3,opt,name=http,proto3,oneof"`
+}
+
+type PluginSource_Oci struct {
+	Oci *PluginSourceOCI `protobuf:"bytes,4,opt,name=oci,proto3,oneof"`
+}
+
 func (*PluginSource_FilePath) isPluginSource_Type() {}
 
 func (*PluginSource_GitHubRelease) isPluginSource_Type() {}
 
+func (*PluginSource_Http) isPluginSource_Type() {}
+
+func (*PluginSource_Oci) isPluginSource_Type() {}
+
 type PluginSourceFilePath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1225,7 +1650,7 @@ type PluginSourceFilePath struct {
 func (x *PluginSourceFilePath) Reset() {
 	*x = PluginSourceFilePath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[18]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +1663,7 @@ func (x *PluginSourceFilePath) String() string {
 func (*PluginSourceFilePath) ProtoMessage() {}
 
 func (x *PluginSourceFilePath) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[18]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1676,7 @@ func (x *PluginSourceFilePath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginSourceFilePath.ProtoReflect.Descriptor instead.
 func (*PluginSourceFilePath) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{18}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PluginSourceFilePath) GetPath() string {
@@ -1266,15 +1691,18 @@ type PluginSourceGitHubRelease struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoOwner string `protobuf:"bytes,1,opt,name=repo_owner,json=repoOwner,proto3" json:"repo_owner,omitempty"`
-	RepoName  string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RepoOwner string           `protobuf:"bytes,1,opt,name=repo_owner,json=repoOwner,proto3" json:"repo_owner,omitempty"`
+	RepoName  string           `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Name      string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string           `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Sha256    string           `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Signature *PluginSignature `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *PluginSourceGitHubRelease) Reset() {
 	*x = PluginSourceGitHubRelease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[19]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1287,7 +1715,7 @@ func (x *PluginSourceGitHubRelease) String() string {
 func (*PluginSourceGitHubRelease) ProtoMessage() {}
 
 func (x *PluginSourceGitHubRelease) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[19]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +1728,7 @@ func (x *PluginSourceGitHubRelease) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginSourceGitHubRelease.ProtoReflect.Descriptor instead.
 func (*PluginSourceGitHubRelease) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{19}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PluginSourceGitHubRelease) GetRepoOwner() string {
@@ -1324,34 +1752,54 @@ func (x *PluginSourceGitHubRelease) GetName() string {
 	return ""
 }
 
-type BlueprintSource struct {
+func (x *PluginSourceGitHubRelease) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PluginSourceGitHubRelease) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *PluginSourceGitHubRelease) GetSignature() *PluginSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type PluginSourceHTTP struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Type:
-	//
-	//	*BlueprintSource_FilePath
-	Type isBlueprintSource_Type `protobuf_oneof:"type"`
+	Url       string           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Sha256    string           `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Signature *PluginSignature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *BlueprintSource) Reset() {
-	*x = BlueprintSource{}
+func (x *PluginSourceHTTP) Reset() {
+	*x = PluginSourceHTTP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[20]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlueprintSource) String() string {
+func (x *PluginSourceHTTP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlueprintSource) ProtoMessage() {}
+func (*PluginSourceHTTP) ProtoMessage() {}
 
-func (x *BlueprintSource) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[20]
+func (x *PluginSourceHTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,12 +1810,203 @@ func (x *BlueprintSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlueprintSource.ProtoReflect.Descriptor instead.
-func (*BlueprintSource) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PluginSourceHTTP.ProtoReflect.Descriptor instead.
+func (*PluginSourceHTTP) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{25}
 }
 
-func (m *BlueprintSource) GetType() isBlueprintSource_Type {
+func (x *PluginSourceHTTP) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PluginSourceHTTP) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *PluginSourceHTTP) GetSignature() *PluginSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type PluginSourceOCI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference string           `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Digest    string           `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Signature *PluginSignature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PluginSourceOCI) Reset() {
+	*x = PluginSourceOCI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginSourceOCI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginSourceOCI) ProtoMessage() {}
+
+func (x *PluginSourceOCI) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginSourceOCI.ProtoReflect.Descriptor instead.
+func (*PluginSourceOCI) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PluginSourceOCI) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PluginSourceOCI) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *PluginSourceOCI) GetSignature() *PluginSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type PluginSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Issuer   string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Bundle   string `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (x *PluginSignature) Reset() {
+	*x = PluginSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginSignature) ProtoMessage() {}
+
+func (x *PluginSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginSignature.ProtoReflect.Descriptor instead.
+func (*PluginSignature) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PluginSignature) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *PluginSignature) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *PluginSignature) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+type BlueprintSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//
+	//	*BlueprintSource_FilePath
+	//	*BlueprintSource_Git
+	Type isBlueprintSource_Type `protobuf_oneof:"type"`
+}
+
+func (x *BlueprintSource) Reset() {
+	*x = BlueprintSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlueprintSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintSource) ProtoMessage() {}
+
+func (x *BlueprintSource) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintSource.ProtoReflect.Descriptor instead.
+func (*BlueprintSource) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{28}
+}
+
+func (m *BlueprintSource) GetType() isBlueprintSource_Type {
 	if m != nil {
 		return m.Type
 	}
@@ -1381,6 +2020,13 @@ func (x *BlueprintSource) GetFilePath() *BlueprintSourceFilePath {
 	return nil
 }
 
+func (x *BlueprintSource) GetGit() *BlueprintSourceGit {
+	if x, ok := x.GetType().(*BlueprintSource_Git); ok {
+		return x.Git
+	}
+	return nil
+}
+
 type isBlueprintSource_Type interface {
 	isBlueprintSource_Type()
 }
@@ -1389,8 +2035,14 @@ type BlueprintSource_FilePath struct {
 	FilePath *BlueprintSourceFilePath `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3,oneof"`
 }
 
+type BlueprintSource_Git struct {
+	Git *BlueprintSourceGit `protobuf:"bytes,2,opt,name=git,proto3,oneof"`
+}
+
 func (*BlueprintSource_FilePath) isBlueprintSource_Type() {}
 
+func (*BlueprintSource_Git) isBlueprintSource_Type() {}
+
 type BlueprintSourceFilePath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1402,7 +2054,7 @@ type BlueprintSourceFilePath struct {
 func (x *BlueprintSourceFilePath) Reset() {
 	*x = BlueprintSourceFilePath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blueprint_v1_blueprint_proto_msgTypes[21]
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1415,7 +2067,7 @@ func (x *BlueprintSourceFilePath) String() string {
 func (*BlueprintSourceFilePath) ProtoMessage() {}
 
 func (x *BlueprintSourceFilePath) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprint_v1_blueprint_proto_msgTypes[21]
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +2080,7 @@ func (x *BlueprintSourceFilePath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlueprintSourceFilePath.ProtoReflect.Descriptor instead.
 func (*BlueprintSourceFilePath) Descriptor() ([]byte, []int) {
-	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{21}
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BlueprintSourceFilePath) GetPath() string {
@@ -1438,6 +2090,69 @@ func (x *BlueprintSourceFilePath) GetPath() string {
 	return ""
 }
 
+type BlueprintSourceGit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo   string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Ref    string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Subdir string `protobuf:"bytes,3,opt,name=subdir,proto3" json:"subdir,omitempty"`
+}
+
+func (x *BlueprintSourceGit) Reset() {
+	*x = BlueprintSourceGit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blueprint_v1_blueprint_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlueprintSourceGit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintSourceGit) ProtoMessage() {}
+
+func (x *BlueprintSourceGit) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprint_v1_blueprint_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintSourceGit.ProtoReflect.Descriptor instead.
+func (*BlueprintSourceGit) Descriptor() ([]byte, []int) {
+	return file_blueprint_v1_blueprint_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BlueprintSourceGit) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *BlueprintSourceGit) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *BlueprintSourceGit) GetSubdir() string {
+	if x != nil {
+		return x.Subdir
+	}
+	return ""
+}
+
 var File_blueprint_v1_blueprint_proto protoreflect.FileDescriptor
 
 var file_blueprint_v1_blueprint_proto_rawDesc = []byte{
@@ -1449,7 +2164,7 @@ var file_blueprint_v1_blueprint_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c,
 	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
 	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x6d, 0x74, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x04, 0x53, 0x74,
+	0x6d, 0x74, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x04, 0x53, 0x74,
 	0x6d, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
 	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
@@ -1459,219 +2174,332 @@ var file_blueprint_v1_blueprint_proto_rawDesc = []byte{
 	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
 	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x48, 0x00, 0x52, 0x05, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0c,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x49, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
-	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
-	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62,
-	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
-	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x12,
-	0x4b, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x69, 0x6c, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b,
 	0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x05,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x6c,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
-	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x8f,
-	0x07, 0x0a, 0x04, 0x45, 0x78, 0x70, 0x72, 0x12, 0x27, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x69, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x62, 0x6f,
-	0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12,
-	0x3f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x74, 0x6d, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e,
 	0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x3c, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e,
-	0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x70, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x4b,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74,
-	0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x48,
-	0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e,
-	0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x03, 0x6e, 0x69, 0x6c, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70,
-	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x72, 0x48,
-	0x00, 0x52, 0x03, 0x6e, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b,
-	0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52,
-	0x03, 0x67, 0x65, 0x74, 0x12, 0x6a, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c,
+	0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
 	0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x3f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x4a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
+	0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb4, 0x01, 0x0a,
+	0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x73, 0x4f, 0x6e, 0x22, 0x5d, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x74, 0x6d,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
+	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x6d, 0x74,
+	0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
+	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a,
+	0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61,
+	0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f,
+	0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x22,
+	0xea, 0x08, 0x0a, 0x04, 0x45, 0x78, 0x70, 0x72, 0x12, 0x27, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0c, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x12, 0x3f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
+	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
 	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
 	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x61, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x2e, 0x4d, 0x61, 0x70, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12,
+	0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
+	0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
+	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x03, 0x6e, 0x69, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x72,
+	0x48, 0x00, 0x52, 0x03, 0x6e, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
+	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00,
+	0x52, 0x03, 0x67, 0x65, 0x74, 0x12, 0x6a, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
+	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x12, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68,
+	0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
+	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
+	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x63, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x6c, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63,
+	0x61, 0x74, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x63, 0x61, 0x74,
+	0x12, 0x4b, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
+	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
 	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e,
 	0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x48, 0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b,
-	0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x72, 0x48,
-	0x00, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x4c, 0x0a, 0x0d, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x45, 0x78, 0x70,
-	0x72, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74,
-	0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x22, 0x4d,
-	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x65, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61,
-	0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f,
-	0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x01,
-	0x0a, 0x07, 0x4d, 0x61, 0x70, 0x45, 0x78, 0x70, 0x72, 0x12, 0x4f, 0x0a, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x6c, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e,
-	0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70,
-	0x45, 0x78, 0x70, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x61, 0x0a, 0x0c, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x40, 0x0a, 0x0a,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x12, 0x0a, 0x03, 0x65, 0x6e,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x49,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x63, 0x61, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x3b, 0x0a, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c,
 	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
 	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
-	0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1e, 0x0a,
-	0x08, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5c, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61,
-	0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f,
-	0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x09, 0x0a, 0x07, 0x4e, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x72, 0x22, 0x69, 0x0a, 0x0c, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x70, 0x72, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x0c, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
+	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x4c, 0x0a, 0x0d, 0x42, 0x6c, 0x75, 0x65,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x6d,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c,
+	0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52,
+	0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
+	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x08, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x45, 0x78, 0x70,
+	0x72, 0x12, 0x4f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
+	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x78, 0x70, 0x72, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x1a, 0x61, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b,
+	0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69,
+	0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x09, 0x0a, 0x07, 0x4e, 0x69, 0x6c, 0x45, 0x78,
+	0x70, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45,
+	0x78, 0x70, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75,
+	0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
 	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
 	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e,
-	0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
-	0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x78, 0x70, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c,
+	0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
+	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x7f, 0x0a, 0x16, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x45, 0x78, 0x70, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
 	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e,
 	0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x7f, 0x0a, 0x16,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x09,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x48, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68,
+	0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4c, 0x0a, 0x0e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74,
+	0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x67, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c,
+	0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0xe1, 0x02, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x54, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b,
+	0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x64, 0x0a, 0x0f, 0x67, 0x69, 0x74, 0x5f, 0x68, 0x75,
+	0x62, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3a, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68,
 	0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf8, 0x01,
-	0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x69,
+	0x74, 0x48, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x67,
+	0x69, 0x74, 0x48, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x6c, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e,
+	0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x44, 0x0a, 0x03, 0x6f, 0x63, 0x69, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
+	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4f, 0x43, 0x49, 0x48, 0x00, 0x52, 0x03, 0x6f, 0x63, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0xed, 0x01, 0x0a, 0x19, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12,
+	0x4e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
+	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x8c, 0x01, 0x0a, 0x10, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x54, 0x54, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x4e,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
 	0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x97,
+	0x01, 0x0a, 0x0f, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f,
+	0x43, 0x49, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6c,
 	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
-	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
-	0x70, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4c, 0x0a, 0x0e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e,
-	0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x67, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6c, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e,
-	0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x54, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70,
-	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x48, 0x00, 0x52, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x64, 0x0a, 0x0f, 0x67, 0x69, 0x74, 0x5f,
-	0x68, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
+	0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x0f, 0x42, 0x6c, 0x75, 0x65,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38,
+	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
+	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x47, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61,
 	0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x0d, 0x67, 0x69, 0x74, 0x48, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x06,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x22, 0x6b, 0x0a, 0x19, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x72, 0x0a, 0x0f, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70,
-	0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
-	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x48,
-	0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x42, 0xa5, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c,
-	0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x42, 0x6c, 0x75, 0x65,
-	0x70, 0x72, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x6b, 0x2f, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2f, 0x76,
-	0x31, 0x3b, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x41, 0x41, 0x42, 0xaa, 0x02, 0x1f, 0x41, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b,
-	0x2e, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1f, 0x41, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x6b, 0x5c, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x5c, 0x42, 0x6c, 0x75, 0x65, 0x70,
-	0x72, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2b, 0x41, 0x6c, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x74, 0x69, 0x6b, 0x5c, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x5c, 0x42, 0x6c, 0x75,
-	0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x41, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x6b, 0x3a, 0x3a, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x3a, 0x3a, 0x42, 0x6c, 0x75,
-	0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x47, 0x69, 0x74, 0x48, 0x00, 0x52, 0x03, 0x67, 0x69, 0x74, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x52, 0x0a, 0x12, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x42, 0xa5, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x61, 0x74, 0x68, 0x61,
+	0x6e, 0x6f, 0x72, 0x2e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x42, 0x0e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2f, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x6f,
+	0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x42, 0xaa, 0x02, 0x1f, 0x41, 0x6c, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x2e, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72, 0x2e, 0x42,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1f, 0x41, 0x6c,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x5c, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f, 0x72,
+	0x5c, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2b,
+	0x41, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x5c, 0x41, 0x74, 0x68, 0x61, 0x6e,
+	0x6f, 0x72, 0x5c, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x41, 0x6c,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x6b, 0x3a, 0x3a, 0x41, 0x74, 0x68, 0x61, 0x6e, 0x6f,
+	0x72, 0x3a, 0x3a, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1686,72 +2514,96 @@ func file_blueprint_v1_blueprint_proto_rawDescGZIP() []byte {
 	return file_blueprint_v1_blueprint_proto_rawDescData
 }
 
-var file_blueprint_v1_blueprint_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_blueprint_v1_blueprint_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_blueprint_v1_blueprint_proto_goTypes = []interface{}{
 	(*Blueprint)(nil),                 // 0: alchematik.athanor.blueprint.v1.Blueprint
 	(*Stmt)(nil),                      // 1: alchematik.athanor.blueprint.v1.Stmt
 	(*ResourceStmt)(nil),              // 2: alchematik.athanor.blueprint.v1.ResourceStmt
-	(*BuildStmt)(nil),                 // 3: alchematik.athanor.blueprint.v1.BuildStmt
-	(*Expr)(nil),                      // 4: alchematik.athanor.blueprint.v1.Expr
-	(*BlueprintExpr)(nil),             // 5: alchematik.athanor.blueprint.v1.BlueprintExpr
-	(*ListExpr)(nil),                  // 6: alchematik.athanor.blueprint.v1.ListExpr
-	(*MapExpr)(nil),                   // 7: alchematik.athanor.blueprint.v1.MapExpr
-	(*FileExpr)(nil),                  // 8: alchematik.athanor.blueprint.v1.FileExpr
-	(*GetExpr)(nil),                   // 9: alchematik.athanor.blueprint.v1.GetExpr
-	(*GetRuntimeConfig)(nil),          // 10: alchematik.athanor.blueprint.v1.GetRuntimeConfig
-	(*NilExpr)(nil),                   // 11: alchematik.athanor.blueprint.v1.NilExpr
-	(*ProviderExpr)(nil),              // 12: alchematik.athanor.blueprint.v1.ProviderExpr
-	(*ResourceExpr)(nil),              // 13: alchematik.athanor.blueprint.v1.ResourceExpr
-	(*ResourceIdentifierExpr)(nil),    // 14: alchematik.athanor.blueprint.v1.ResourceIdentifierExpr
-	(*BuildExpr)(nil),                 // 15: alchematik.athanor.blueprint.v1.BuildExpr
-	(*Translator)(nil),                // 16: alchematik.athanor.blueprint.v1.Translator
-	(*PluginSource)(nil),              // 17: alchematik.athanor.blueprint.v1.PluginSource
-	(*PluginSourceFilePath)(nil),      // 18: alchematik.athanor.blueprint.v1.PluginSourceFilePath
-	(*PluginSourceGitHubRelease)(nil), // 19: alchematik.athanor.blueprint.v1.PluginSourceGitHubRelease
-	(*BlueprintSource)(nil),           // 20: alchematik.athanor.blueprint.v1.BlueprintSource
-	(*BlueprintSourceFilePath)(nil),   // 21: alchematik.athanor.blueprint.v1.BlueprintSourceFilePath
-	nil,                               // 22: alchematik.athanor.blueprint.v1.MapExpr.EntriesEntry
+	(*ResourceOptions)(nil),           // 3: alchematik.athanor.blueprint.v1.ResourceOptions
+	(*OutputStmt)(nil),                // 4: alchematik.athanor.blueprint.v1.OutputStmt
+	(*BuildStmt)(nil),                 // 5: alchematik.athanor.blueprint.v1.BuildStmt
+	(*Expr)(nil),                      // 6: alchematik.athanor.blueprint.v1.Expr
+	(*SecretExpr)(nil),                // 7: alchematik.athanor.blueprint.v1.SecretExpr
+	(*ConcatExpr)(nil),                // 8: alchematik.athanor.blueprint.v1.ConcatExpr
+	(*FunctionExpr)(nil),              // 9: alchematik.athanor.blueprint.v1.FunctionExpr
+	(*BlueprintExpr)(nil),             // 10: alchematik.athanor.blueprint.v1.BlueprintExpr
+	(*ListExpr)(nil),                  // 11: alchematik.athanor.blueprint.v1.ListExpr
+	(*MapExpr)(nil),                   // 12: alchematik.athanor.blueprint.v1.MapExpr
+	(*FileExpr)(nil),                  // 13: alchematik.athanor.blueprint.v1.FileExpr
+	(*GetExpr)(nil),                   // 14: alchematik.athanor.blueprint.v1.GetExpr
+	(*GetRuntimeConfig)(nil),          // 15: alchematik.athanor.blueprint.v1.GetRuntimeConfig
+	(*NilExpr)(nil),                   // 16: alchematik.athanor.blueprint.v1.NilExpr
+	(*ProviderExpr)(nil),              // 17: alchematik.athanor.blueprint.v1.ProviderExpr
+	(*ResourceExpr)(nil),              // 18: alchematik.athanor.blueprint.v1.ResourceExpr
+	(*ResourceIdentifierExpr)(nil),    // 19: alchematik.athanor.blueprint.v1.ResourceIdentifierExpr
+	(*BuildExpr)(nil),                 // 20: alchematik.athanor.blueprint.v1.BuildExpr
+	(*Translator)(nil),                // 21: alchematik.athanor.blueprint.v1.Translator
+	(*PluginSource)(nil),              // 22: alchematik.athanor.blueprint.v1.PluginSource
+	(*PluginSourceFilePath)(nil),      // 23: alchematik.athanor.blueprint.v1.PluginSourceFilePath
+	(*PluginSourceGitHubRelease)(nil), // 24: alchematik.athanor.blueprint.v1.PluginSourceGitHubRelease
+	(*PluginSourceHTTP)(nil),          // 25: alchematik.athanor.blueprint.v1.PluginSourceHTTP
+	(*PluginSourceOCI)(nil),           // 26: alchematik.athanor.blueprint.v1.PluginSourceOCI
+	(*PluginSignature)(nil),           // 27: alchematik.athanor.blueprint.v1.PluginSignature
+	(*BlueprintSource)(nil),           // 28: alchematik.athanor.blueprint.v1.BlueprintSource
+	(*BlueprintSourceFilePath)(nil),   // 29: alchematik.athanor.blueprint.v1.BlueprintSourceFilePath
+	(*BlueprintSourceGit)(nil),        // 30: alchematik.athanor.blueprint.v1.BlueprintSourceGit
+	nil,                               // 31: alchematik.athanor.blueprint.v1.MapExpr.EntriesEntry
 }
 var file_blueprint_v1_blueprint_proto_depIdxs = []int32{
 	1,  // 0: alchematik.athanor.blueprint.v1.Blueprint.stmts:type_name -> alchematik.athanor.blueprint.v1.Stmt
 	2,  // 1: alchematik.athanor.blueprint.v1.Stmt.resource:type_name -> alchematik.athanor.blueprint.v1.ResourceStmt
-	3,  // 2: alchematik.athanor.blueprint.v1.Stmt.build:type_name -> alchematik.athanor.blueprint.v1.BuildStmt
-	13, // 3: alchematik.athanor.blueprint.v1.ResourceStmt.resource:type_name -> alchematik.athanor.blueprint.v1.ResourceExpr
-	12, // 4: alchematik.athanor.blueprint.v1.ResourceStmt.provider:type_name -> alchematik.athanor.blueprint.v1.ProviderExpr
-	4,  // 5: alchematik.athanor.blueprint.v1.ResourceStmt.exists:type_name -> alchematik.athanor.blueprint.v1.Expr
-	16, // 6: alchematik.athanor.blueprint.v1.BuildStmt.translator:type_name -> alchematik.athanor.blueprint.v1.Translator
-	15, // 7: alchematik.athanor.blueprint.v1.BuildStmt.build:type_name -> alchematik.athanor.blueprint.v1.BuildExpr
-	6,  // 8: alchematik.athanor.blueprint.v1.Expr.list:type_name -> alchematik.athanor.blueprint.v1.ListExpr
-	7,  // 9: alchematik.athanor.blueprint.v1.Expr.map:type_name -> alchematik.athanor.blueprint.v1.MapExpr
-	12, // 10: alchematik.athanor.blueprint.v1.Expr.provider:type_name -> alchematik.athanor.blueprint.v1.ProviderExpr
-	13, // 11: alchematik.athanor.blueprint.v1.Expr.resource:type_name -> alchematik.athanor.blueprint.v1.ResourceExpr
-	11, // 12: alchematik.athanor.blueprint.v1.Expr.nil:type_name -> alchematik.athanor.blueprint.v1.NilExpr
-	9,  // 13: alchematik.athanor.blueprint.v1.Expr.get:type_name -> alchematik.athanor.blueprint.v1.GetExpr
-	14, // 14: alchematik.athanor.blueprint.v1.Expr.resource_identifier:type_name -> alchematik.athanor.blueprint.v1.ResourceIdentifierExpr
-	8,  // 15: alchematik.athanor.blueprint.v1.Expr.file:type_name -> alchematik.athanor.blueprint.v1.FileExpr
-	10, // 16: alchematik.athanor.blueprint.v1.Expr.get_runtime_config:type_name -> alchematik.athanor.blueprint.v1.GetRuntimeConfig
-	15, // 17: alchematik.athanor.blueprint.v1.Expr.build:type_name -> alchematik.athanor.blueprint.v1.BuildExpr
-	1,  // 18: alchematik.athanor.blueprint.v1.BlueprintExpr.stmts:type_name -> alchematik.athanor.blueprint.v1.Stmt
-	4,  // 19: alchematik.athanor.blueprint.v1.ListExpr.elements:type_name -> alchematik.athanor.blueprint.v1.Expr
-	22, // 20: alchematik.athanor.blueprint.v1.MapExpr.entries:type_name -> alchematik.athanor.blueprint.v1.MapExpr.EntriesEntry
-	4,  // 21: alchematik.athanor.blueprint.v1.GetExpr.object:type_name -> alchematik.athanor.blueprint.v1.Expr
-	17, // 22: alchematik.athanor.blueprint.v1.ProviderExpr.source:type_name -> alchematik.athanor.blueprint.v1.PluginSource
-	4,  // 23: alchematik.athanor.blueprint.v1.ResourceExpr.identifier:type_name -> alchematik.athanor.blueprint.v1.Expr
-	4,  // 24: alchematik.athanor.blueprint.v1.ResourceExpr.config:type_name -> alchematik.athanor.blueprint.v1.Expr
-	4,  // 25: alchematik.athanor.blueprint.v1.ResourceIdentifierExpr.value:type_name -> alchematik.athanor.blueprint.v1.Expr
-	20, // 26: alchematik.athanor.blueprint.v1.BuildExpr.source:type_name -> alchematik.athanor.blueprint.v1.BlueprintSource
-	4,  // 27: alchematik.athanor.blueprint.v1.BuildExpr.config:type_name -> alchematik.athanor.blueprint.v1.Expr
-	4,  // 28: alchematik.athanor.blueprint.v1.BuildExpr.runtime_config:type_name -> alchematik.athanor.blueprint.v1.Expr
-	17, // 29: alchematik.athanor.blueprint.v1.Translator.source:type_name -> alchematik.athanor.blueprint.v1.PluginSource
-	18, // 30: alchematik.athanor.blueprint.v1.PluginSource.file_path:type_name -> alchematik.athanor.blueprint.v1.PluginSourceFilePath
-	19, // 31: alchematik.athanor.blueprint.v1.PluginSource.git_hub_release:type_name -> alchematik.athanor.blueprint.v1.PluginSourceGitHubRelease
-	21, // 32: alchematik.athanor.blueprint.v1.BlueprintSource.file_path:type_name -> alchematik.athanor.blueprint.v1.BlueprintSourceFilePath
-	4,  // 33: alchematik.athanor.blueprint.v1.MapExpr.EntriesEntry.value:type_name -> alchematik.athanor.blueprint.v1.Expr
-	34, // [34:34] is the sub-list for method output_type
-	34, // [34:34] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	5,  // 2: alchematik.athanor.blueprint.v1.Stmt.build:type_name -> alchematik.athanor.blueprint.v1.BuildStmt
+	4,  // 3: alchematik.athanor.blueprint.v1.Stmt.output:type_name -> alchematik.athanor.blueprint.v1.OutputStmt
+	18, // 4: alchematik.athanor.blueprint.v1.ResourceStmt.resource:type_name -> alchematik.athanor.blueprint.v1.ResourceExpr
+	17, // 5: alchematik.athanor.blueprint.v1.ResourceStmt.provider:type_name -> alchematik.athanor.blueprint.v1.ProviderExpr
+	6,  // 6: alchematik.athanor.blueprint.v1.ResourceStmt.exists:type_name -> alchematik.athanor.blueprint.v1.Expr
+	3,  // 7: alchematik.athanor.blueprint.v1.ResourceStmt.options:type_name -> alchematik.athanor.blueprint.v1.ResourceOptions
+	6,  // 8: alchematik.athanor.blueprint.v1.OutputStmt.value:type_name -> alchematik.athanor.blueprint.v1.Expr
+	21, // 9: alchematik.athanor.blueprint.v1.BuildStmt.translator:type_name -> alchematik.athanor.blueprint.v1.Translator
+	20, // 10: alchematik.athanor.blueprint.v1.BuildStmt.build:type_name -> alchematik.athanor.blueprint.v1.BuildExpr
+	11, // 11: alchematik.athanor.blueprint.v1.Expr.list:type_name -> alchematik.athanor.blueprint.v1.ListExpr
+	12, // 12: alchematik.athanor.blueprint.v1.Expr.map:type_name -> alchematik.athanor.blueprint.v1.MapExpr
+	17, // 13: alchematik.athanor.blueprint.v1.Expr.provider:type_name -> alchematik.athanor.blueprint.v1.ProviderExpr
+	18, // 14: alchematik.athanor.blueprint.v1.Expr.resource:type_name -> alchematik.athanor.blueprint.v1.ResourceExpr
+	16, // 15: alchematik.athanor.blueprint.v1.Expr.nil:type_name -> alchematik.athanor.blueprint.v1.NilExpr
+	14, // 16: alchematik.athanor.blueprint.v1.Expr.get:type_name -> alchematik.athanor.blueprint.v1.GetExpr
+	19, // 17: alchematik.athanor.blueprint.v1.Expr.resource_identifier:type_name -> alchematik.athanor.blueprint.v1.ResourceIdentifierExpr
+	13, // 18: alchematik.athanor.blueprint.v1.Expr.file:type_name -> alchematik.athanor.blueprint.v1.FileExpr
+	15, // 19: alchematik.athanor.blueprint.v1.Expr.get_runtime_config:type_name -> alchematik.athanor.blueprint.v1.GetRuntimeConfig
+	20, // 20: alchematik.athanor.blueprint.v1.Expr.build:type_name -> alchematik.athanor.blueprint.v1.BuildExpr
+	8,  // 21: alchematik.athanor.blueprint.v1.Expr.concat:type_name -> alchematik.athanor.blueprint.v1.ConcatExpr
+	9,  // 22: alchematik.athanor.blueprint.v1.Expr.function:type_name -> alchematik.athanor.blueprint.v1.FunctionExpr
+	7,  // 23: alchematik.athanor.blueprint.v1.Expr.secret:type_name -> alchematik.athanor.blueprint.v1.SecretExpr
+	6,  // 24: alchematik.athanor.blueprint.v1.ConcatExpr.parts:type_name -> alchematik.athanor.blueprint.v1.Expr
+	6,  // 25: alchematik.athanor.blueprint.v1.FunctionExpr.args:type_name -> alchematik.athanor.blueprint.v1.Expr
+	1,  // 26: alchematik.athanor.blueprint.v1.BlueprintExpr.stmts:type_name -> alchematik.athanor.blueprint.v1.Stmt
+	6,  // 27: alchematik.athanor.blueprint.v1.ListExpr.elements:type_name -> alchematik.athanor.blueprint.v1.Expr
+	31, // 28: alchematik.athanor.blueprint.v1.MapExpr.entries:type_name -> alchematik.athanor.blueprint.v1.MapExpr.EntriesEntry
+	6,  // 29: alchematik.athanor.blueprint.v1.GetExpr.object:type_name -> alchematik.athanor.blueprint.v1.Expr
+	22, // 30: alchematik.athanor.blueprint.v1.ProviderExpr.source:type_name -> alchematik.athanor.blueprint.v1.PluginSource
+	6,  // 31: alchematik.athanor.blueprint.v1.ProviderExpr.config:type_name -> alchematik.athanor.blueprint.v1.Expr
+	6,  // 32: alchematik.athanor.blueprint.v1.ResourceExpr.identifier:type_name -> alchematik.athanor.blueprint.v1.Expr
+	6,  // 33: alchematik.athanor.blueprint.v1.ResourceExpr.config:type_name -> alchematik.athanor.blueprint.v1.Expr
+	6,  // 34: alchematik.athanor.blueprint.v1.ResourceIdentifierExpr.value:type_name -> alchematik.athanor.blueprint.v1.Expr
+	28, // 35: alchematik.athanor.blueprint.v1.BuildExpr.source:type_name -> alchematik.athanor.blueprint.v1.BlueprintSource
+	6,  // 36: alchematik.athanor.blueprint.v1.BuildExpr.config:type_name -> alchematik.athanor.blueprint.v1.Expr
+	6,  // 37: alchematik.athanor.blueprint.v1.BuildExpr.runtime_config:type_name -> alchematik.athanor.blueprint.v1.Expr
+	22, // 38: alchematik.athanor.blueprint.v1.Translator.source:type_name -> alchematik.athanor.blueprint.v1.PluginSource
+	23, // 39: alchematik.athanor.blueprint.v1.PluginSource.file_path:type_name -> alchematik.athanor.blueprint.v1.PluginSourceFilePath
+	24, // 40: alchematik.athanor.blueprint.v1.PluginSource.git_hub_release:type_name -> alchematik.athanor.blueprint.v1.PluginSourceGitHubRelease
+	25, // 41: alchematik.athanor.blueprint.v1.PluginSource.http:type_name -> alchematik.athanor.blueprint.v1.PluginSourceHTTP
+	26, // 42: alchematik.athanor.blueprint.v1.PluginSource.oci:type_name -> alchematik.athanor.blueprint.v1.PluginSourceOCI
+	27, // 43: alchematik.athanor.blueprint.v1.PluginSourceGitHubRelease.signature:type_name -> alchematik.athanor.blueprint.v1.PluginSignature
+	27, // 44: alchematik.athanor.blueprint.v1.PluginSourceHTTP.signature:type_name -> alchematik.athanor.blueprint.v1.PluginSignature
+	27, // 45: alchematik.athanor.blueprint.v1.PluginSourceOCI.signature:type_name -> alchematik.athanor.blueprint.v1.PluginSignature
+	29, // 46: alchematik.athanor.blueprint.v1.BlueprintSource.file_path:type_name -> alchematik.athanor.blueprint.v1.BlueprintSourceFilePath
+	30, // 47: alchematik.athanor.blueprint.v1.BlueprintSource.git:type_name -> alchematik.athanor.blueprint.v1.BlueprintSourceGit
+	6,  // 48: alchematik.athanor.blueprint.v1.MapExpr.EntriesEntry.value:type_name -> alchematik.athanor.blueprint.v1.Expr
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_blueprint_v1_blueprint_proto_init() }
@@ -1797,7 +2649,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildStmt); i {
+			switch v := v.(*ResourceOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1809,7 +2661,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expr); i {
+			switch v := v.(*OutputStmt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1821,7 +2673,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlueprintExpr); i {
+			switch v := v.(*BuildStmt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1833,7 +2685,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExpr); i {
+			switch v := v.(*Expr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1845,7 +2697,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapExpr); i {
+			switch v := v.(*SecretExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1857,7 +2709,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileExpr); i {
+			switch v := v.(*ConcatExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1869,7 +2721,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExpr); i {
+			switch v := v.(*FunctionExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1881,7 +2733,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRuntimeConfig); i {
+			switch v := v.(*BlueprintExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1893,7 +2745,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NilExpr); i {
+			switch v := v.(*ListExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1905,7 +2757,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderExpr); i {
+			switch v := v.(*MapExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1917,7 +2769,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceExpr); i {
+			switch v := v.(*FileExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1929,7 +2781,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceIdentifierExpr); i {
+			switch v := v.(*GetExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1941,7 +2793,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildExpr); i {
+			switch v := v.(*GetRuntimeConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1953,7 +2805,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Translator); i {
+			switch v := v.(*NilExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1965,7 +2817,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PluginSource); i {
+			switch v := v.(*ProviderExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1977,7 +2829,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PluginSourceFilePath); i {
+			switch v := v.(*ResourceExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1989,7 +2841,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PluginSourceGitHubRelease); i {
+			switch v := v.(*ResourceIdentifierExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2001,7 +2853,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlueprintSource); i {
+			switch v := v.(*BuildExpr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2013,6 +2865,102 @@ func file_blueprint_v1_blueprint_proto_init() {
 			}
 		}
 		file_blueprint_v1_blueprint_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Translator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginSourceFilePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginSourceGitHubRelease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginSourceHTTP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginSourceOCI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlueprintSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blueprint_v1_blueprint_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlueprintSourceFilePath); i {
 			case 0:
 				return &v.state
@@ -2024,12 +2972,25 @@ func file_blueprint_v1_blueprint_proto_init() {
 				return nil
 			}
 		}
+		file_blueprint_v1_blueprint_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlueprintSourceGit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_blueprint_v1_blueprint_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*Stmt_Resource)(nil),
 		(*Stmt_Build)(nil),
+		(*Stmt_Output)(nil),
 	}
-	file_blueprint_v1_blueprint_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_blueprint_v1_blueprint_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*Expr_StringLiteral)(nil),
 		(*Expr_IntLiteral)(nil),
 		(*Expr_FloatLiteral)(nil),
@@ -2044,13 +3005,23 @@ func file_blueprint_v1_blueprint_proto_init() {
 		(*Expr_File)(nil),
 		(*Expr_GetRuntimeConfig)(nil),
 		(*Expr_Build)(nil),
+		(*Expr_Concat)(nil),
+		(*Expr_Function)(nil),
+		(*Expr_Secret)(nil),
+	}
+	file_blueprint_v1_blueprint_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*SecretExpr_Env)(nil),
+		(*SecretExpr_File)(nil),
 	}
-	file_blueprint_v1_blueprint_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_blueprint_v1_blueprint_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*PluginSource_FilePath)(nil),
 		(*PluginSource_GitHubRelease)(nil),
+		(*PluginSource_Http)(nil),
+		(*PluginSource_Oci)(nil),
 	}
-	file_blueprint_v1_blueprint_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_blueprint_v1_blueprint_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*BlueprintSource_FilePath)(nil),
+		(*BlueprintSource_Git)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2058,7 +3029,7 @@ func file_blueprint_v1_blueprint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_blueprint_v1_blueprint_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,9 @@ var structTypeTmpl string
 //go:embed identifier_struct_type.tmpl
 var identifierStructTypeTmpl string
 
+//go:embed ref_type.tmpl
+var refTypeTmpl string
+
 func GenerateResourceSrc(schema *providerpb.Schema, resource *providerpb.ResourceSchema) ([]byte, error) {
 	tmpl, err := template.New("resource").
 		Funcs(template.FuncMap{
@@ -32,8 +35,14 @@ func GenerateResourceSrc(schema *providerpb.Schema, resource *providerpb.Resourc
 		return nil, err
 	}
 
+	attrs := resource.GetAttrs()
+	if attrs.GetStructSchema() != nil {
+		attrs.GetStructSchema().Name = "attrs"
+	}
+
 	data := map[string]any{
 		"PackageName": resource.GetType(),
+		"HasAttrs":    attrs.GetStructSchema() != nil,
 	}
 
 	var buf bytes.Buffer
@@ -59,6 +68,14 @@ func GenerateResourceSrc(schema *providerpb.Schema, resource *providerpb.Resourc
 
 	findStructs(typesMap, config)
 
+	// Attrs are only read by consumers, so in addition to their value types
+	// they get reference types with an accessor per field.
+	refTypesMap := map[string]*providerpb.FieldSchema{}
+	findStructs(refTypesMap, attrs)
+	for k, v := range refTypesMap {
+		typesMap[k] = v
+	}
+
 	var names []string
 	for k := range typesMap {
 		names = append(names, k)
@@ -85,6 +102,15 @@ func GenerateResourceSrc(schema *providerpb.Schema, resource *providerpb.Resourc
 
 				out = append(out, o...)
 			}
+
+			if _, ok := refTypesMap[name]; ok {
+				o, err := generateRefType(t.GetStructSchema())
+				if err != nil {
+					return nil, err
+				}
+
+				out = append(out, o...)
+			}
 		default:
 			return nil, fmt.Errorf("unsupported type: %s", t.GetType())
 		}
@@ -104,6 +130,10 @@ func findStructs(m map[string]*providerpb.FieldSchema, field *providerpb.FieldSc
 		findStructs(m, t.MapSchema.GetValue())
 	case *providerpb.FieldSchema_ListSchema:
 		findStructs(m, t.ListSchema.GetElement())
+	case *providerpb.FieldSchema_ImmutableSchema:
+		findStructs(m, t.ImmutableSchema.GetValue())
+	case *providerpb.FieldSchema_SensitiveSchema:
+		findStructs(m, t.SensitiveSchema.GetValue())
 	}
 }
 
@@ -111,6 +141,7 @@ func generateStructType(name string, t *providerpb.StructSchema) ([]byte, error)
 	tmpl, err := template.New("struct_type").
 		Funcs(template.FuncMap{
 			"toPascalCase": util.PascalCase,
+			"toType":       toType,
 		}).
 		Parse(structTypeTmpl)
 	if err != nil {
@@ -134,6 +165,7 @@ func generateIdentifierStructType(name string, t *providerpb.StructSchema) ([]by
 	tmpl, err := template.New("identifier_struct_type").
 		Funcs(template.FuncMap{
 			"toPascalCase": util.PascalCase,
+			"toType":       toType,
 		}).
 		Parse(identifierStructTypeTmpl)
 	if err != nil {
@@ -152,3 +184,111 @@ func generateIdentifierStructType(name string, t *providerpb.StructSchema) ([]by
 
 	return buffer.Bytes(), nil
 }
+
+func generateRefType(t *providerpb.StructSchema) ([]byte, error) {
+	tmpl, err := template.New("ref_type").
+		Funcs(template.FuncMap{
+			"toPascalCase": util.PascalCase,
+			"toType":       toType,
+			"toValueType":  toValueType,
+			"toRefType":    toRefType,
+			"isStruct":     isStruct,
+		}).
+		Parse(refTypeTmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]any{
+		"Type": t,
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// toRefType returns the type of a reference accessor: struct fields have their
+// own reference types so that accessors can be chained, all other fields are
+// referenced as an sdk.Expr.
+func toRefType(f *providerpb.FieldSchema) (string, error) {
+	if s := structSchema(f); s != nil {
+		return util.PascalCase(s.GetName()) + "Ref", nil
+	}
+
+	return toType(f)
+}
+
+func isStruct(f *providerpb.FieldSchema) bool {
+	return structSchema(f) != nil
+}
+
+func structSchema(f *providerpb.FieldSchema) *providerpb.StructSchema {
+	if imm, ok := f.GetType().(*providerpb.FieldSchema_ImmutableSchema); ok {
+		return structSchema(imm.ImmutableSchema.GetValue())
+	}
+
+	if sen, ok := f.GetType().(*providerpb.FieldSchema_SensitiveSchema); ok {
+		return structSchema(sen.SensitiveSchema.GetValue())
+	}
+
+	return f.GetStructSchema()
+}
+
+// toType returns the type of a generated field: an sdk.Expr of the Go type
+// that corresponds to the field's schema.
+func toType(f *providerpb.FieldSchema) (string, error) {
+	if imm, ok := f.GetType().(*providerpb.FieldSchema_ImmutableSchema); ok {
+		return toType(imm.ImmutableSchema.GetValue())
+	}
+
+	if sen, ok := f.GetType().(*providerpb.FieldSchema_SensitiveSchema); ok {
+		return toType(sen.SensitiveSchema.GetValue())
+	}
+
+	t, err := toValueType(f)
+	if err != nil {
+		return "", err
+	}
+
+	return "sdk.Expr[" + t + "]", nil
+}
+
+// toValueType returns the Go type that corresponds to the field's schema. The
+// elements of maps and lists are sdk.Exprs themselves, so that they can be
+// references as well as literals.
+func toValueType(f *providerpb.FieldSchema) (string, error) {
+	switch val := f.GetType().(type) {
+	case *providerpb.FieldSchema_StringSchema:
+		return "string", nil
+	case *providerpb.FieldSchema_BoolSchema:
+		return "bool", nil
+	case *providerpb.FieldSchema_StructSchema:
+		return util.PascalCase(val.StructSchema.GetName()), nil
+	case *providerpb.FieldSchema_MapSchema:
+		valType, err := toType(val.MapSchema.GetValue())
+		if err != nil {
+			return "", err
+		}
+		return "map[string]" + valType, nil
+	case *providerpb.FieldSchema_ListSchema:
+		valType, err := toType(val.ListSchema.GetElement())
+		if err != nil {
+			return "", err
+		}
+		return "[]" + valType, nil
+	case *providerpb.FieldSchema_FileSchema:
+		return "sdk.File", nil
+	case *providerpb.FieldSchema_IdentifierSchema:
+		return "sdk.Identifier", nil
+	case *providerpb.FieldSchema_ImmutableSchema:
+		return toValueType(val.ImmutableSchema.GetValue())
+	case *providerpb.FieldSchema_SensitiveSchema:
+		return toValueType(val.SensitiveSchema.GetValue())
+	default:
+		return "", fmt.Errorf("unrecognized type: %s", f.GetType())
+	}
+}
@@ -0,0 +1,47 @@
+package consumer
+
+import (
+	"testing"
+
+	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
+)
+
+func TestToType(t *testing.T) {
+	str := &providerpb.FieldSchema{Type: &providerpb.FieldSchema_StringSchema{StringSchema: &providerpb.StringSchema{}}}
+	rule := &providerpb.FieldSchema{Type: &providerpb.FieldSchema_StructSchema{StructSchema: &providerpb.StructSchema{Name: "rule"}}}
+	mapOf := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_MapSchema{MapSchema: &providerpb.MapSchema{Value: v}}}
+	}
+	listOf := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_ListSchema{ListSchema: &providerpb.ListSchema{Element: v}}}
+	}
+	immutable := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_ImmutableSchema{ImmutableSchema: &providerpb.ImmutableSchema{Value: v}}}
+	}
+
+	tests := []struct {
+		name  string
+		field *providerpb.FieldSchema
+		want  string
+	}{
+		{name: "string", field: str, want: "sdk.Expr[string]"},
+		{name: "struct", field: rule, want: "sdk.Expr[Rule]"},
+		{name: "immutable", field: immutable(str), want: "sdk.Expr[string]"},
+		{name: "map", field: mapOf(str), want: "sdk.Expr[map[string]sdk.Expr[string]]"},
+		{name: "list", field: listOf(rule), want: "sdk.Expr[[]sdk.Expr[Rule]]"},
+		{name: "nested", field: listOf(mapOf(immutable(str))), want: "sdk.Expr[[]sdk.Expr[map[string]sdk.Expr[string]]]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toType(tt.field)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
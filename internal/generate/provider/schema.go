@@ -219,6 +219,10 @@ func findStructs(m map[string]*providerpb.FieldSchema, field *providerpb.FieldSc
 		findStructs(m, t.MapSchema.GetValue())
 	case *providerpb.FieldSchema_ListSchema:
 		findStructs(m, t.ListSchema.GetElement())
+	case *providerpb.FieldSchema_ImmutableSchema:
+		findStructs(m, t.ImmutableSchema.GetValue())
+	case *providerpb.FieldSchema_SensitiveSchema:
+		findStructs(m, t.SensitiveSchema.GetValue())
 	}
 }
 
@@ -284,6 +288,13 @@ func parseFieldFunc(idPackage string) func(string, *providerpb.FieldSchema) (str
 			return "sdk.Bool", nil
 		case *providerpb.FieldSchema_ImmutableSchema:
 			return parseFieldFunc(idPackage)(name, val.ImmutableSchema.GetValue())
+		case *providerpb.FieldSchema_SensitiveSchema:
+			parse, err := parseFieldFunc(idPackage)(name, val.SensitiveSchema.GetValue())
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("sdk.ParseSensitive(%s)", parse), nil
 		default:
 			return "", fmt.Errorf("unsupported type %T", f.GetType())
 		}
@@ -342,6 +353,8 @@ func toType(f *providerpb.FieldSchema) (string, error) {
 		return "bool", nil
 	case *providerpb.FieldSchema_ImmutableSchema:
 		return toType(val.ImmutableSchema.GetValue())
+	case *providerpb.FieldSchema_SensitiveSchema:
+		return "sdk.Sensitive", nil
 	default:
 		return "", fmt.Errorf("unrecognized type: %s", f.GetType())
 	}
@@ -369,6 +382,12 @@ func toTypeFunc(f *providerpb.FieldSchema) (string, error) {
 			return "", err
 		}
 		return fmt.Sprintf("sdk.ToImmutableType(%s)", subType), nil
+	case *providerpb.FieldSchema_SensitiveSchema:
+		subType, err := toTypeFunc(val.SensitiveSchema.GetValue())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("sdk.ToSensitiveType(%s)", subType), nil
 	default:
 		return "sdk.ToType[any]", nil
 	}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
+)
+
+func TestToTypeFunc(t *testing.T) {
+	str := &providerpb.FieldSchema{Type: &providerpb.FieldSchema_StringSchema{StringSchema: &providerpb.StringSchema{}}}
+	mapOf := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_MapSchema{MapSchema: &providerpb.MapSchema{Value: v}}}
+	}
+	listOf := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_ListSchema{ListSchema: &providerpb.ListSchema{Element: v}}}
+	}
+	immutable := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_ImmutableSchema{ImmutableSchema: &providerpb.ImmutableSchema{Value: v}}}
+	}
+	sensitive := func(v *providerpb.FieldSchema) *providerpb.FieldSchema {
+		return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_SensitiveSchema{SensitiveSchema: &providerpb.SensitiveSchema{Value: v}}}
+	}
+
+	tests := []struct {
+		name  string
+		field *providerpb.FieldSchema
+		want  string
+	}{
+		{name: "string", field: str, want: "sdk.ToType[any]"},
+		{name: "map", field: mapOf(str), want: "sdk.ToType[string]"},
+		{name: "list", field: listOf(str), want: "sdk.ToType[string]"},
+		{name: "immutable map", field: immutable(mapOf(str)), want: "sdk.ToImmutableType(sdk.ToType[string])"},
+		{name: "sensitive", field: sensitive(str), want: "sdk.ToSensitiveType(sdk.ToType[any])"},
+		{name: "sensitive map", field: sensitive(mapOf(str)), want: "sdk.ToSensitiveType(sdk.ToType[string])"},
+		{name: "sensitive list", field: sensitive(listOf(str)), want: "sdk.ToSensitiveType(sdk.ToType[string])"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toTypeFunc(tt.field)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
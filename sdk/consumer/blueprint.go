@@ -1,10 +1,12 @@
 package sdk
 
 import (
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-	"log"
-	"os"
+	"math"
+	"reflect"
+	"strings"
 
 	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
 )
@@ -13,11 +15,12 @@ type Blueprint struct {
 	stmts []any
 }
 
-func (b Blueprint) WithResource(exists any, provider Provider, resource Resource) Blueprint {
+func (b Blueprint) WithResource(exists any, provider Provider, resource Resource, opts ...ResourceOption) Blueprint {
 	b.stmts = append(b.stmts, resourceStmt{
 		exists:   exists,
 		provider: provider,
 		resource: resource,
+		opts:     opts,
 	})
 	return b
 }
@@ -34,6 +37,17 @@ func (b Blueprint) WithBuild(alias string, repo BlueprintSource, translator Tran
 	return b
 }
 
+// WithOutput exports value from the blueprint under name. A parent blueprint
+// can reference it with BuildOutput.
+func (b Blueprint) WithOutput(name string, value any) Blueprint {
+	b.stmts = append(b.stmts, outputStmt{
+		name:  name,
+		value: value,
+	})
+
+	return b
+}
+
 type buildStmt struct {
 	alias         string
 	repo          BlueprintSource
@@ -42,6 +56,11 @@ type buildStmt struct {
 	runtimeConfig any
 }
 
+type outputStmt struct {
+	name  string
+	value any
+}
+
 type Translator struct {
 	Source PluginSource
 	Name   string
@@ -51,6 +70,7 @@ type resourceStmt struct {
 	exists   any
 	resource Resource
 	provider Provider
+	opts     []ResourceOption
 }
 
 type File struct {
@@ -70,6 +90,12 @@ type Resource struct {
 
 type Provider struct {
 	Source PluginSource
+	// Alias distinguishes instances of the same provider that have different
+	// configs.
+	Alias string
+	// Config is passed to the provider when it is configured, e.g.
+	// credentials, a region or an endpoint.
+	Config any
 }
 
 type PluginSource interface {
@@ -82,12 +108,43 @@ type PluginSourceFilePath struct {
 	Path string
 }
 
+// PluginSourceGitHubRelease is the asset Name of a GitHub release. SHA256 is
+// hex encoded; LockFile.Pin sets it from a lock file.
 type PluginSourceGitHubRelease struct {
 	PluginSource
 
 	RepoOwner string
 	RepoName  string
 	Name      string
+	Version   string
+	SHA256    string
+	Signature *PluginSignature
+}
+
+// PluginSourceHTTP is a plugin downloaded from URL. SHA256 is hex encoded.
+type PluginSourceHTTP struct {
+	PluginSource
+
+	URL       string
+	SHA256    string
+	Signature *PluginSignature
+}
+
+// PluginSourceOCI is a plugin in an OCI registry. Digest is "sha256:<hex>".
+type PluginSourceOCI struct {
+	PluginSource
+
+	Reference string
+	Digest    string
+	Signature *PluginSignature
+}
+
+// PluginSignature is a sigstore signature issued to Identity by Issuer. Bundle
+// defaults to the plugin's location with a ".sigstore.json" suffix.
+type PluginSignature struct {
+	Identity string
+	Issuer   string
+	Bundle   string
 }
 
 type BlueprintSource interface {
@@ -100,6 +157,15 @@ type BlueprintSourceFilePath struct {
 	Path string
 }
 
+// BlueprintSourceGit is a blueprint in Subdir of Repo at Ref.
+type BlueprintSourceGit struct {
+	BlueprintSource
+
+	Repo   string
+	Ref    string
+	Subdir string
+}
+
 type Get struct {
 	Name   string
 	Object any
@@ -118,131 +184,16 @@ func GetResource(alias string) Get {
 	}
 }
 
+// BuildOutput returns a reference to the output name of the build declared
+// with alias.
+func BuildOutput(alias, name string) Get {
+	return GetResource(alias).Get("outputs").Get(name)
+}
+
 type RuntimeConfig struct{}
 
 type BlueprintFunc func(args ...any) (Blueprint, error)
 
-func Build(bf BlueprintFunc) {
-	configPath := os.Args[0]
-	log.Printf("ARGS >>> %v\n", os.Args)
-
-	configData, err := os.ReadFile(configPath)
-	if err != nil {
-		log.Fatalf("error opening config file: %v", err)
-	}
-
-	configs := []*blueprintpb.Expr{}
-	if err := json.Unmarshal(configData, &configs); err != nil {
-		log.Fatalf("error unmarshaling config: %v", err)
-	}
-
-	configExprs := make([]any, len(configs))
-	for i, c := range configs {
-		config, err := fromProtoToExpr(c)
-		if err != nil {
-			log.Fatalf("error converting config: %v", err)
-		}
-
-		configExprs[i] = config
-	}
-
-	bp, err := bf(configExprs...)
-	if err != nil {
-		log.Fatalf("error building blueprint: %v", err)
-	}
-
-	outputPath := os.Args[1]
-
-	f, err := os.OpenFile(outputPath, os.O_APPEND|os.O_WRONLY, os.ModeAppend)
-	if err != nil {
-		log.Fatalf("error opening output path: %v", err)
-	}
-
-	p := &blueprintpb.Blueprint{}
-	for _, stmt := range bp.stmts {
-		switch s := stmt.(type) {
-		case resourceStmt:
-			res, err := toResourceExprProto(s.resource)
-			if err != nil {
-				log.Fatalf("error converting statement into proto: %v", err)
-			}
-
-			exists, err := toExprProto(s.exists)
-			if err != nil {
-				log.Fatalf("error converting statement into proto: %v", err)
-			}
-
-			provider, err := toProviderExprProto(s.provider)
-			if err != nil {
-				log.Fatalf("error converting statement into proto: %v", err)
-			}
-
-			p.Stmts = append(p.Stmts, &blueprintpb.Stmt{
-				Type: &blueprintpb.Stmt_Resource{
-					Resource: &blueprintpb.ResourceStmt{
-						Exists:   exists,
-						Resource: res,
-						Provider: provider,
-					},
-				},
-			})
-		case buildStmt:
-			configs := make([]*blueprintpb.Expr, len(s.configs))
-			for i, c := range s.configs {
-				config, err := toExprProto(c)
-				if err != nil {
-					log.Fatalf("error converting build config: %v", err)
-				}
-
-				configs[i] = config
-			}
-
-			runtimeConfig, err := toExprProto(s.runtimeConfig)
-			if err != nil {
-				log.Fatalf("error converting runtime config: %v", err)
-			}
-
-			r, err := blueprintSourceToProto(s.repo)
-			if err != nil {
-				log.Fatalf("error converting repo: %v", err)
-			}
-
-			tr, err := pluginSourceToProto(s.translator.Source)
-			if err != nil {
-				log.Fatalf("error converting translator repo: %v", err)
-			}
-
-			p.Stmts = append(p.Stmts, &blueprintpb.Stmt{
-				Type: &blueprintpb.Stmt_Build{
-					Build: &blueprintpb.BuildStmt{
-						Translator: &blueprintpb.Translator{
-							Source: tr,
-							Name:   s.translator.Name,
-						},
-						Build: &blueprintpb.BuildExpr{
-							Alias:         s.alias,
-							Source:        r,
-							Config:        configs,
-							RuntimeConfig: runtimeConfig,
-						},
-					},
-				},
-			})
-		default:
-			log.Fatalf("invalid statement type: %T", stmt)
-		}
-	}
-
-	data, err := json.Marshal(p)
-	if err != nil {
-		log.Fatalf("error marshaling blueprint: %v", err)
-	}
-
-	if _, err := f.Write(data); err != nil {
-		log.Fatalf("error writing blueprint to file: %v", err)
-	}
-}
-
 func blueprintSourceToProto(s BlueprintSource) (*blueprintpb.BlueprintSource, error) {
 	switch s := s.(type) {
 	case BlueprintSourceFilePath:
@@ -253,6 +204,20 @@ func blueprintSourceToProto(s BlueprintSource) (*blueprintpb.BlueprintSource, er
 				},
 			},
 		}, nil
+	case BlueprintSourceGit:
+		if s.Repo == "" || s.Ref == "" {
+			return nil, fmt.Errorf("git blueprint source requires a repo and a ref")
+		}
+
+		return &blueprintpb.BlueprintSource{
+			Type: &blueprintpb.BlueprintSource_Git{
+				Git: &blueprintpb.BlueprintSourceGit{
+					Repo:   s.Repo,
+					Ref:    s.Ref,
+					Subdir: s.Subdir,
+				},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid blueprint source: %T", s)
 	}
@@ -269,12 +234,70 @@ func pluginSourceToProto(s PluginSource) (*blueprintpb.PluginSource, error) {
 			},
 		}, nil
 	case PluginSourceGitHubRelease:
+		if !isSHA256(s.SHA256) {
+			return nil, fmt.Errorf("github plugin source %s/%s/%s: invalid sha256 %q", s.RepoOwner, s.RepoName, s.Name, s.SHA256)
+		}
+
+		sig, err := pluginSignatureToProto(s.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("github plugin source %s/%s/%s: %v", s.RepoOwner, s.RepoName, s.Name, err)
+		}
+
 		return &blueprintpb.PluginSource{
 			Type: &blueprintpb.PluginSource_GitHubRelease{
 				GitHubRelease: &blueprintpb.PluginSourceGitHubRelease{
 					RepoOwner: s.RepoOwner,
 					RepoName:  s.RepoName,
 					Name:      s.Name,
+					Version:   s.Version,
+					Sha256:    s.SHA256,
+					Signature: sig,
+				},
+			},
+		}, nil
+	case PluginSourceHTTP:
+		if s.URL == "" {
+			return nil, fmt.Errorf("http plugin source requires a url")
+		}
+
+		if !isSHA256(s.SHA256) {
+			return nil, fmt.Errorf("http plugin source %s: invalid sha256 %q", s.URL, s.SHA256)
+		}
+
+		sig, err := pluginSignatureToProto(s.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("http plugin source %s: %v", s.URL, err)
+		}
+
+		return &blueprintpb.PluginSource{
+			Type: &blueprintpb.PluginSource_Http{
+				Http: &blueprintpb.PluginSourceHTTP{
+					Url:       s.URL,
+					Sha256:    s.SHA256,
+					Signature: sig,
+				},
+			},
+		}, nil
+	case PluginSourceOCI:
+		if s.Reference == "" {
+			return nil, fmt.Errorf("oci plugin source requires a reference")
+		}
+
+		if algo, digest, ok := strings.Cut(s.Digest, ":"); !ok || algo != "sha256" || !isSHA256(digest) {
+			return nil, fmt.Errorf("oci plugin source %s: invalid digest %q", s.Reference, s.Digest)
+		}
+
+		sig, err := pluginSignatureToProto(s.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("oci plugin source %s: %v", s.Reference, err)
+		}
+
+		return &blueprintpb.PluginSource{
+			Type: &blueprintpb.PluginSource_Oci{
+				Oci: &blueprintpb.PluginSourceOCI{
+					Reference: s.Reference,
+					Digest:    s.Digest,
+					Signature: sig,
 				},
 			},
 		}, nil
@@ -283,6 +306,32 @@ func pluginSourceToProto(s PluginSource) (*blueprintpb.PluginSource, error) {
 	}
 }
 
+func pluginSignatureToProto(sig *PluginSignature) (*blueprintpb.PluginSignature, error) {
+	if sig == nil {
+		return nil, nil
+	}
+
+	if sig.Identity == "" || sig.Issuer == "" {
+		return nil, fmt.Errorf("signature requires an identity and an issuer")
+	}
+
+	return &blueprintpb.PluginSignature{
+		Identity: sig.Identity,
+		Issuer:   sig.Issuer,
+		Bundle:   sig.Bundle,
+	}, nil
+}
+
+// isSHA256 reports whether s is a hex encoded SHA-256 digest.
+func isSHA256(s string) bool {
+	if len(s) != 2*sha256.Size {
+		return false
+	}
+
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
 type exprConvertable interface {
 	ToExpr() any
 }
@@ -397,17 +446,153 @@ func toExprProto(expr any) (*blueprintpb.Expr, error) {
 				GetRuntimeConfig: &blueprintpb.GetRuntimeConfig{},
 			},
 		}, nil
+	case Concat:
+		parts, err := toExprProtos(e.Parts)
+		if err != nil {
+			return nil, err
+		}
+
+		return &blueprintpb.Expr{
+			Type: &blueprintpb.Expr_Concat{
+				Concat: &blueprintpb.ConcatExpr{
+					Parts: parts,
+				},
+			},
+		}, nil
+	case Secret:
+		secret := &blueprintpb.SecretExpr{}
+		switch {
+		case e.Env != "" && e.File != "":
+			return nil, fmt.Errorf("secret must have either an env or a file source, not both")
+		case e.Env != "":
+			secret.Source = &blueprintpb.SecretExpr_Env{Env: e.Env}
+		case e.File != "":
+			secret.Source = &blueprintpb.SecretExpr_File{File: e.File}
+		default:
+			return nil, fmt.Errorf("secret has no source")
+		}
+
+		return &blueprintpb.Expr{
+			Type: &blueprintpb.Expr_Secret{
+				Secret: secret,
+			},
+		}, nil
+	case formatExpr:
+		c, err := e.concat()
+		if err != nil {
+			return nil, err
+		}
+
+		return toExprProto(c)
+	case FunctionCall:
+		if err := e.check(); err != nil {
+			return nil, err
+		}
+
+		args, err := toExprProtos(e.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return &blueprintpb.Expr{
+			Type: &blueprintpb.Expr_Function{
+				Function: &blueprintpb.FunctionExpr{
+					Name: e.Name,
+					Args: args,
+				},
+			},
+		}, nil
 	case exprConvertable:
 		return toExprProto(e.ToExpr())
 	case nil:
 		return &blueprintpb.Expr{
 			Type: &blueprintpb.Expr_Nil{},
 		}, nil
+	default:
+		return reflectExprProto(expr)
+	}
+}
+
+func toExprProtos(exprs []any) ([]*blueprintpb.Expr, error) {
+	p := make([]*blueprintpb.Expr, len(exprs))
+	for i, e := range exprs {
+		var err error
+		p[i], err = toExprProto(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// reflectExprProto converts numbers, including named types such as
+// `type Replicas int`, and maps with string keys and slices of any element
+// type, such as the map[string]string and []string fields of generated
+// resource types.
+func reflectExprProto(expr any) (*blueprintpb.Expr, error) {
+	v := reflect.ValueOf(expr)
+	switch {
+	case v.Kind() == reflect.String:
+		return toExprProto(v.String())
+	case v.Kind() == reflect.Bool:
+		return toExprProto(v.Bool())
+	case v.CanInt():
+		return toIntLiteralProto(v.Int())
+	case v.CanUint():
+		if v.Uint() > math.MaxUint32 {
+			return nil, fmt.Errorf("int literal out of range: %d", v.Uint())
+		}
+
+		return toIntLiteralProto(int64(v.Uint()))
+	case v.CanFloat():
+		return toFloatLiteralProto(v.Float())
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[iter.Key().String()] = iter.Value().Interface()
+		}
+
+		return toExprProto(m)
+	case v.Kind() == reflect.Slice:
+		l := make([]any, v.Len())
+		for i := range l {
+			l[i] = v.Index(i).Interface()
+		}
+
+		return toExprProto(l)
 	default:
 		return nil, fmt.Errorf("unsupported expression: %T", expr)
 	}
 }
 
+// Int literals are encoded as uint32 in the blueprint protocol.
+func toIntLiteralProto(v int64) (*blueprintpb.Expr, error) {
+	if v < 0 || v > math.MaxUint32 {
+		return nil, fmt.Errorf("int literal out of range: %d", v)
+	}
+
+	return &blueprintpb.Expr{
+		Type: &blueprintpb.Expr_IntLiteral{
+			IntLiteral: uint32(v),
+		},
+	}, nil
+}
+
+// Float literals are encoded as float32 in the blueprint protocol.
+func toFloatLiteralProto(v float64) (*blueprintpb.Expr, error) {
+	if math.Abs(v) > math.MaxFloat32 && !math.IsInf(v, 0) {
+		return nil, fmt.Errorf("float literal out of range: %g", v)
+	}
+
+	return &blueprintpb.Expr{
+		Type: &blueprintpb.Expr_FloatLiteral{
+			FloatLiteral: float32(v),
+		},
+	}, nil
+}
+
 func toResourceExprProto(res Resource) (*blueprintpb.ResourceExpr, error) {
 	id, err := toExprProto(res.Identifier)
 	if err != nil {
@@ -431,8 +616,18 @@ func toProviderExprProto(p Provider) (*blueprintpb.ProviderExpr, error) {
 		return nil, err
 	}
 
+	var config *blueprintpb.Expr
+	if p.Config != nil {
+		config, err = toExprProto(p.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error converting provider config: %v", err)
+		}
+	}
+
 	return &blueprintpb.ProviderExpr{
 		Source: s,
+		Config: config,
+		Alias:  p.Alias,
 	}, nil
 }
 
@@ -442,6 +637,10 @@ func fromProtoToExpr(p *blueprintpb.Expr) (any, error) {
 		return t.StringLiteral, nil
 	case *blueprintpb.Expr_BoolLiteral:
 		return t.BoolLiteral, nil
+	case *blueprintpb.Expr_IntLiteral:
+		return int(t.IntLiteral), nil
+	case *blueprintpb.Expr_FloatLiteral:
+		return float64(t.FloatLiteral), nil
 	case *blueprintpb.Expr_List:
 		l := make([]any, len(t.List.GetElements()))
 		for i, e := range t.List.GetElements() {
@@ -468,7 +667,144 @@ func fromProtoToExpr(p *blueprintpb.Expr) (any, error) {
 		return m, nil
 	case *blueprintpb.Expr_Nil:
 		return nil, nil
+	case *blueprintpb.Expr_File:
+		return File{Path: t.File.GetPath()}, nil
+	case *blueprintpb.Expr_ResourceIdentifier:
+		val, err := fromProtoToExpr(t.ResourceIdentifier.GetValue())
+		if err != nil {
+			return nil, err
+		}
+
+		return ResourceIdentifier{
+			Alias:        t.ResourceIdentifier.GetAlias(),
+			ResourceType: t.ResourceIdentifier.GetType(),
+			Value:        val,
+		}, nil
+	case *blueprintpb.Expr_Resource:
+		id, err := fromProtoToExpr(t.Resource.GetIdentifier())
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := fromProtoToExpr(t.Resource.GetConfig())
+		if err != nil {
+			return nil, err
+		}
+
+		return Resource{
+			Identifier: id,
+			Config:     config,
+		}, nil
+	case *blueprintpb.Expr_Provider:
+		src, err := pluginSourceFromProto(t.Provider.GetSource())
+		if err != nil {
+			return nil, err
+		}
+
+		var config any
+		if t.Provider.GetConfig() != nil {
+			config, err = fromProtoToExpr(t.Provider.GetConfig())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return Provider{
+			Source: src,
+			Alias:  t.Provider.GetAlias(),
+			Config: config,
+		}, nil
+	case *blueprintpb.Expr_Get:
+		obj, err := fromProtoToExpr(t.Get.GetObject())
+		if err != nil {
+			return nil, err
+		}
+
+		return Get{
+			Name:   t.Get.GetName(),
+			Object: obj,
+		}, nil
+	case *blueprintpb.Expr_GetRuntimeConfig:
+		return RuntimeConfig{}, nil
+	case *blueprintpb.Expr_Concat:
+		parts, err := fromProtoToExprs(t.Concat.GetParts())
+		if err != nil {
+			return nil, err
+		}
+
+		return Concat{Parts: parts}, nil
+	case *blueprintpb.Expr_Function:
+		args, err := fromProtoToExprs(t.Function.GetArgs())
+		if err != nil {
+			return nil, err
+		}
+
+		return FunctionCall{Name: t.Function.GetName(), Args: args}, nil
+	case *blueprintpb.Expr_Secret:
+		return Secret{
+			Env:  t.Secret.GetEnv(),
+			File: t.Secret.GetFile(),
+		}, nil
+	case *blueprintpb.Expr_Build:
+		return nil, fmt.Errorf("build expressions can't be decoded, use WithBuild instead")
+	case nil:
+		return nil, nil
 	default:
-		return nil, fmt.Errorf("invalid expr type: %T", p)
+		return nil, fmt.Errorf("invalid expr type: %T", t)
+	}
+}
+
+func fromProtoToExprs(p []*blueprintpb.Expr) ([]any, error) {
+	exprs := make([]any, len(p))
+	for i, e := range p {
+		var err error
+		exprs[i], err = fromProtoToExpr(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return exprs, nil
+}
+
+func pluginSourceFromProto(p *blueprintpb.PluginSource) (PluginSource, error) {
+	switch t := p.GetType().(type) {
+	case *blueprintpb.PluginSource_FilePath:
+		return PluginSourceFilePath{Path: t.FilePath.GetPath()}, nil
+	case *blueprintpb.PluginSource_GitHubRelease:
+		return PluginSourceGitHubRelease{
+			RepoOwner: t.GitHubRelease.GetRepoOwner(),
+			RepoName:  t.GitHubRelease.GetRepoName(),
+			Name:      t.GitHubRelease.GetName(),
+			Version:   t.GitHubRelease.GetVersion(),
+			SHA256:    t.GitHubRelease.GetSha256(),
+			Signature: pluginSignatureFromProto(t.GitHubRelease.GetSignature()),
+		}, nil
+	case *blueprintpb.PluginSource_Http:
+		return PluginSourceHTTP{
+			URL:       t.Http.GetUrl(),
+			SHA256:    t.Http.GetSha256(),
+			Signature: pluginSignatureFromProto(t.Http.GetSignature()),
+		}, nil
+	case *blueprintpb.PluginSource_Oci:
+		return PluginSourceOCI{
+			Reference: t.Oci.GetReference(),
+			Digest:    t.Oci.GetDigest(),
+			Signature: pluginSignatureFromProto(t.Oci.GetSignature()),
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid plugin source type: %T", t)
+	}
+}
+
+func pluginSignatureFromProto(p *blueprintpb.PluginSignature) *PluginSignature {
+	if p == nil {
+		return nil
+	}
+
+	return &PluginSignature{
+		Identity: p.GetIdentity(),
+		Issuer:   p.GetIssuer(),
+		Bundle:   p.GetBundle(),
 	}
 }
@@ -0,0 +1,268 @@
+package sdk
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+)
+
+type replicas int
+
+type port uint16
+
+type ratio float32
+
+func TestNumericLiterals(t *testing.T) {
+	tests := []struct {
+		name string
+		expr any
+		want any
+		err  string
+	}{
+		{name: "int", expr: 3, want: 3},
+		{name: "int8", expr: int8(3), want: 3},
+		{name: "int64", expr: int64(math.MaxUint32), want: math.MaxUint32},
+		{name: "uint", expr: uint(3), want: 3},
+		{name: "uint8", expr: uint8(3), want: 3},
+		{name: "uint32", expr: uint32(math.MaxUint32), want: math.MaxUint32},
+		{name: "named int", expr: replicas(3), want: 3},
+		{name: "named uint", expr: port(8080), want: 8080},
+		{name: "float32", expr: float32(0.5), want: 0.5},
+		{name: "float64", expr: 0.5, want: 0.5},
+		{name: "named float", expr: ratio(0.25), want: 0.25},
+		{name: "negative int", expr: -1, err: "int literal out of range: -1"},
+		{name: "negative named int", expr: replicas(-1), err: "int literal out of range: -1"},
+		{name: "int above uint32", expr: int64(math.MaxUint32 + 1), err: "int literal out of range: 4294967296"},
+		{name: "uint above uint32", expr: uint64(math.MaxUint32 + 1), err: "int literal out of range: 4294967296"},
+		{name: "float above float32", expr: math.MaxFloat64, err: "float literal out of range: 1.7976931348623157e+308"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := toExprProto(tt.expr)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("got error %v, want %q", err, tt.err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := fromProtoToExpr(p)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestExprRoundTrip(t *testing.T) {
+	sha := strings.Repeat("ab", 32)
+	sig := &PluginSignature{Identity: "release@example.com", Issuer: "https://issuer.example.com", Bundle: "bundle.json"}
+	bucket := GetResource("bucket")
+
+	tests := []struct {
+		name string
+		expr any
+	}{
+		{name: "nil", expr: nil},
+		{name: "literals", expr: []any{"a", true, 3, 0.5}},
+		{name: "map", expr: map[string]any{"a": "b", "c": []any{1}}},
+		{name: "file", expr: File{Path: "main.zip"}},
+		{name: "identifier", expr: ResourceIdentifier{Alias: "bucket", ResourceType: "bucket", Value: map[string]any{"name": "b"}}},
+		{
+			name: "resource",
+			expr: Resource{
+				Identifier: ResourceIdentifier{Alias: "bucket", ResourceType: "bucket", Value: "b"},
+				Config:     map[string]any{"region": "us-east1"},
+			},
+		},
+		{name: "file provider", expr: Provider{Source: PluginSourceFilePath{Path: "provider"}}},
+		{
+			name: "github provider",
+			expr: Provider{
+				Source: PluginSourceGitHubRelease{RepoOwner: "alchematik", RepoName: "athanor-provider-gcp", Name: "provider", Version: "v0.1.0", SHA256: sha, Signature: sig},
+				Alias:  "prod",
+				Config: map[string]any{"region": "us-east1"},
+			},
+		},
+		{name: "http provider", expr: Provider{Source: PluginSourceHTTP{URL: "https://example.com/provider", SHA256: sha, Signature: sig}}},
+		{name: "oci provider", expr: Provider{Source: PluginSourceOCI{Reference: "registry.example.com/provider:v1", Digest: "sha256:" + sha, Signature: sig}}},
+		{name: "get", expr: bucket.Get("attrs").Get("name")},
+		{name: "runtime config", expr: RuntimeConfig{}},
+		{name: "concat", expr: Concat{Parts: []any{"gs://", bucket.Get("attrs").Get("name")}}},
+		{name: "function", expr: FunctionCall{Name: "join", Args: []any{",", []any{"a", bucket}}}},
+		{name: "secret env", expr: Secret{Env: "PASSWORD"}},
+		{name: "secret file", expr: Secret{File: "password.txt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := toExprProto(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			data, err := json.Marshal(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var decoded blueprintpb.Expr
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := fromProtoToExpr(&decoded)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expr) {
+				t.Errorf("got %#v, want %#v", got, tt.expr)
+			}
+		})
+	}
+}
+
+func TestProviderProto(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider Provider
+		config   bool
+	}{
+		{name: "default", provider: Provider{Source: PluginSourceFilePath{Path: "provider"}}},
+		{name: "alias", provider: Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod"}},
+		{
+			name:     "config",
+			provider: Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod", Config: map[string]any{"region": "us-east1"}},
+			config:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := toProviderExprProto(tt.provider)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if p.GetAlias() != tt.provider.Alias {
+				t.Errorf("got alias %q, want %q", p.GetAlias(), tt.provider.Alias)
+			}
+
+			if got := p.GetConfig() != nil; got != tt.config {
+				t.Errorf("got config set %t, want %t", got, tt.config)
+			}
+
+			got, err := fromProtoToExpr(&blueprintpb.Expr{Type: &blueprintpb.Expr_Provider{Provider: p}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.provider) {
+				t.Errorf("got %#v, want %#v", got, tt.provider)
+			}
+		})
+	}
+}
+
+func TestSourceProtoErrors(t *testing.T) {
+	sha := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name string
+		conv func() error
+		want string
+	}{
+		{
+			name: "http without url",
+			conv: pluginSourceErr(PluginSourceHTTP{SHA256: sha}),
+			want: "http plugin source requires a url",
+		},
+		{
+			name: "http with bad sha256",
+			conv: pluginSourceErr(PluginSourceHTTP{URL: "https://example.com/provider", SHA256: "abc"}),
+			want: `http plugin source https://example.com/provider: invalid sha256 "abc"`,
+		},
+		{
+			name: "http without sha256",
+			conv: pluginSourceErr(PluginSourceHTTP{URL: "https://example.com/provider"}),
+			want: `http plugin source https://example.com/provider: invalid sha256 ""`,
+		},
+		{
+			name: "github with bad sha256",
+			conv: pluginSourceErr(PluginSourceGitHubRelease{RepoOwner: "a", RepoName: "b", Name: "c", SHA256: "xyz"}),
+			want: `github plugin source a/b/c: invalid sha256 "xyz"`,
+		},
+		{
+			name: "github without sha256",
+			conv: pluginSourceErr(PluginSourceGitHubRelease{RepoOwner: "a", RepoName: "b", Name: "c", Version: "v1"}),
+			want: `github plugin source a/b/c: invalid sha256 ""`,
+		},
+		{
+			name: "oci without reference",
+			conv: pluginSourceErr(PluginSourceOCI{Digest: "sha256:" + sha}),
+			want: "oci plugin source requires a reference",
+		},
+		{
+			name: "oci digest without algorithm",
+			conv: pluginSourceErr(PluginSourceOCI{Reference: "registry.example.com/provider", Digest: sha}),
+			want: `oci plugin source registry.example.com/provider: invalid digest "` + sha + `"`,
+		},
+		{
+			name: "oci digest with other algorithm",
+			conv: pluginSourceErr(PluginSourceOCI{Reference: "registry.example.com/provider", Digest: "sha512:" + sha}),
+			want: `oci plugin source registry.example.com/provider: invalid digest "sha512:` + sha + `"`,
+		},
+		{
+			name: "signature without issuer",
+			conv: pluginSourceErr(PluginSourceHTTP{URL: "https://example.com/provider", SHA256: sha, Signature: &PluginSignature{Identity: "ci"}}),
+			want: "http plugin source https://example.com/provider: signature requires an identity and an issuer",
+		},
+		{
+			name: "git without repo",
+			conv: blueprintSourceErr(BlueprintSourceGit{Ref: "main"}),
+			want: "git blueprint source requires a repo and a ref",
+		},
+		{
+			name: "git without ref",
+			conv: blueprintSourceErr(BlueprintSourceGit{Repo: "https://github.com/alchematik/blueprints"}),
+			want: "git blueprint source requires a repo and a ref",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.conv(); err == nil || err.Error() != tt.want {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func pluginSourceErr(s PluginSource) func() error {
+	return func() error {
+		_, err := pluginSourceToProto(s)
+		return err
+	}
+}
+
+func blueprintSourceErr(s BlueprintSource) func() error {
+	return func() error {
+		_, err := blueprintSourceToProto(s)
+		return err
+	}
+}
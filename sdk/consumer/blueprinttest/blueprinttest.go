@@ -0,0 +1,82 @@
+// Package blueprinttest provides helpers for snapshot testing blueprints.
+//
+// A test compiles a blueprint and compares a human-readable rendering of it
+// against a golden file:
+//
+//	func TestBlueprint(t *testing.T) {
+//		blueprinttest.Golden(t, "testdata/prod.golden", blueprint, "prod")
+//	}
+//
+// Running the tests with -blueprinttest.update rewrites the golden files with
+// the current output, so that changes to the emitted blueprint show up in code
+// review. The flag is namespaced so that it doesn't clash with a test binary's
+// own -update flag.
+package blueprinttest
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+var update = flag.Bool("blueprinttest.update", false, "update blueprint golden files")
+
+// Compile compiles bf with configs, failing the test on error.
+func Compile(t testing.TB, bf sdk.BlueprintFunc, configs ...any) *blueprintpb.Blueprint {
+	t.Helper()
+
+	bp, err := sdk.Compile(bf, configs)
+	if err != nil {
+		t.Fatalf("error compiling blueprint: %v", err)
+	}
+
+	return bp
+}
+
+// Golden compiles bf with configs and compares its rendering with the golden
+// file at path. When the test binary is run with -blueprinttest.update, the
+// golden file is written instead.
+func Golden(t testing.TB, path string, bf sdk.BlueprintFunc, configs ...any) {
+	t.Helper()
+
+	AssertGolden(t, path, Compile(t, bf, configs...))
+}
+
+// AssertGolden compares the rendering of bp with the golden file at path.
+// When the test binary is run with -blueprinttest.update, the golden file is
+// written instead.
+func AssertGolden(t testing.TB, path string, bp *blueprintpb.Blueprint) {
+	t.Helper()
+
+	got := Render(bp)
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatalf("error creating golden file dir: %v", err)
+		}
+
+		if err := os.WriteFile(path, []byte(got), 0666); err != nil {
+			t.Fatalf("error writing golden file: %v", err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("golden file %s does not exist, run with -blueprinttest.update to create it", path)
+	}
+	if err != nil {
+		t.Fatalf("error reading golden file: %v", err)
+	}
+
+	if string(want) != got {
+		t.Errorf("blueprint does not match %s (-want +got):\n%s", path, Diff(string(want), got))
+	}
+}
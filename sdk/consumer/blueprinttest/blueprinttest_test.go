@@ -0,0 +1,68 @@
+package blueprinttest_test
+
+import (
+	"flag"
+	"testing"
+
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+	"github.com/alchematik/athanor-go/sdk/consumer/blueprinttest"
+)
+
+func TestUpdateFlag(t *testing.T) {
+	if flag.Lookup("update") != nil {
+		t.Errorf("blueprinttest registers -update, which clashes with test binaries' own flags")
+	}
+
+	if flag.Lookup("blueprinttest.update") == nil {
+		t.Errorf("blueprinttest doesn't register -blueprinttest.update")
+	}
+}
+
+func TestRender(t *testing.T) {
+	blueprinttest.Golden(t, "testdata/render.golden", example, "prod")
+}
+
+func example(args ...any) (sdk.Blueprint, error) {
+	env, _ := args[0].(string)
+
+	provider := sdk.Provider{
+		Source: sdk.PluginSourceGitHubRelease{
+			RepoOwner: "alchematik",
+			RepoName:  "athanor-provider-gcp",
+			Name:      "provider",
+			Version:   "v0.1.0",
+			SHA256:    "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		},
+		Config: map[string]any{"region": "us-east1"},
+	}
+
+	bucket := sdk.ResourceIdentifier{
+		Alias:        "bucket",
+		ResourceType: "bucket",
+		Value:        map[string]any{"name": sdk.Format("%s-bucket", env)},
+	}
+
+	b := sdk.Blueprint{}.
+		WithResource(true, provider, sdk.Resource{
+			Identifier: bucket,
+			Config: map[string]any{
+				"labels":    map[string]any{"env": env},
+				"replicas":  3,
+				"versioned": true,
+			},
+		}, sdk.PreventDestroy()).
+		WithResource(true, provider, sdk.Resource{
+			Identifier: sdk.ResourceIdentifier{
+				Alias:        "object",
+				ResourceType: "object",
+				Value:        map[string]any{"bucket": bucket, "name": "index.html"},
+			},
+			Config: map[string]any{
+				"contents":   sdk.File{Path: "index.html"},
+				"bucket_url": sdk.GetResource("bucket").Get("attrs").Get("url"),
+			},
+		}, sdk.DependsOn("bucket")).
+		WithOutput("url", sdk.GetResource("bucket").Get("attrs").Get("url"))
+
+	return b, nil
+}
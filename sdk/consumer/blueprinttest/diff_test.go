@@ -0,0 +1,48 @@
+package blueprinttest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		got  string
+		diff string
+	}{
+		{
+			name: "equal",
+			want: "a\nb",
+			got:  "a\nb",
+			diff: "...\n",
+		},
+		{
+			name: "changed",
+			want: "a\nb\nc",
+			got:  "a\nx\nc",
+			diff: "  a\n- b\n+ x\n  c\n",
+		},
+		{
+			name: "added and removed",
+			want: "a\nb",
+			got:  "b\nc",
+			diff: "- a\n  b\n+ c\n",
+		},
+		{
+			name: "elided context",
+			want: strings.Join([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}, "\n"),
+			got:  strings.Join([]string{"1", "2", "3", "4", "5", "6", "7", "8", "x"}, "\n"),
+			diff: "...\n  6\n  7\n  8\n- 9\n+ x\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.want, tt.got); got != tt.diff {
+				t.Errorf("got diff:\n%s\nwant:\n%s", got, tt.diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package blueprinttest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+)
+
+// Render returns a stable, human-readable rendering of bp. Map entries are
+// sorted by key, so the same blueprint always renders the same way.
+func Render(bp *blueprintpb.Blueprint) string {
+	r := &renderer{}
+	for i, stmt := range bp.GetStmts() {
+		if i > 0 {
+			r.newline()
+		}
+
+		r.stmt(stmt)
+		r.newline()
+	}
+
+	return r.sb.String()
+}
+
+type renderer struct {
+	sb     strings.Builder
+	indent int
+}
+
+func (r *renderer) printf(format string, args ...any) {
+	fmt.Fprintf(&r.sb, format, args...)
+}
+
+func (r *renderer) newline() {
+	r.sb.WriteByte('\n')
+	r.sb.WriteString(strings.Repeat("  ", r.indent))
+}
+
+func (r *renderer) field(name string, e *blueprintpb.Expr) {
+	r.newline()
+	r.printf("%s: ", name)
+	r.expr(e)
+}
+
+func (r *renderer) stmt(stmt *blueprintpb.Stmt) {
+	switch s := stmt.GetType().(type) {
+	case *blueprintpb.Stmt_Resource:
+		res := s.Resource
+		r.printf("resource %s {", strconv.Quote(res.GetResource().GetIdentifier().GetResourceIdentifier().GetAlias()))
+		r.indent++
+		r.origin(stmt.GetOrigin())
+		r.field("exists", res.GetExists())
+		r.newline()
+		r.printf("provider: ")
+		r.provider(res.GetProvider())
+		r.field("identifier", res.GetResource().GetIdentifier())
+		r.field("config", res.GetResource().GetConfig())
+		r.resourceOptions(res.GetOptions())
+		r.indent--
+		r.newline()
+		r.printf("}")
+	case *blueprintpb.Stmt_Build:
+		build := s.Build
+		r.printf("build %s {", strconv.Quote(build.GetBuild().GetAlias()))
+		r.indent++
+		r.origin(stmt.GetOrigin())
+		r.newline()
+		r.printf("translator: %s ", strconv.Quote(build.GetTranslator().GetName()))
+		r.pluginSource(build.GetTranslator().GetSource())
+		r.newline()
+		r.printf("source: ")
+		r.blueprintSource(build.GetBuild().GetSource())
+		r.field("runtime_config", build.GetBuild().GetRuntimeConfig())
+		r.newline()
+		r.printf("config: ")
+		r.list(build.GetBuild().GetConfig())
+		r.indent--
+		r.newline()
+		r.printf("}")
+	case *blueprintpb.Stmt_Output:
+		r.printf("output %s {", strconv.Quote(s.Output.GetName()))
+		r.indent++
+		r.origin(stmt.GetOrigin())
+		r.field("value", s.Output.GetValue())
+		r.indent--
+		r.newline()
+		r.printf("}")
+	default:
+		r.printf("<unknown statement %T>", s)
+	}
+}
+
+// resourceOptions renders the options that are set, if any.
+func (r *renderer) resourceOptions(opts *blueprintpb.ResourceOptions) {
+	if opts.GetPreventDestroy() {
+		r.newline()
+		r.printf("prevent_destroy: true")
+	}
+
+	if len(opts.GetIgnoreChanges()) > 0 {
+		r.newline()
+		r.printf("ignore_changes: [%s]", quoteAll(opts.GetIgnoreChanges()))
+	}
+
+	if opts.GetCreateBeforeDestroy() {
+		r.newline()
+		r.printf("create_before_destroy: true")
+	}
+
+	if len(opts.GetDependsOn()) > 0 {
+		r.newline()
+		r.printf("depends_on: [%s]", quoteAll(opts.GetDependsOn()))
+	}
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func (r *renderer) call(name string, args []*blueprintpb.Expr) {
+	r.printf("%s(", name)
+	for i, arg := range args {
+		if i > 0 {
+			r.printf(", ")
+		}
+
+		r.expr(arg)
+	}
+	r.printf(")")
+}
+
+func (r *renderer) origin(origin string) {
+	if origin == "" {
+		return
+	}
+
+	r.newline()
+	r.printf("origin: %s", strconv.Quote(origin))
+}
+
+func (r *renderer) expr(e *blueprintpb.Expr) {
+	switch t := e.GetType().(type) {
+	case nil, *blueprintpb.Expr_Nil:
+		r.printf("nil")
+	case *blueprintpb.Expr_StringLiteral:
+		r.printf("%s", strconv.Quote(t.StringLiteral))
+	case *blueprintpb.Expr_BoolLiteral:
+		r.printf("%t", t.BoolLiteral)
+	case *blueprintpb.Expr_IntLiteral:
+		r.printf("%d", t.IntLiteral)
+	case *blueprintpb.Expr_FloatLiteral:
+		r.printf("%s", strconv.FormatFloat(float64(t.FloatLiteral), 'g', -1, 32))
+	case *blueprintpb.Expr_List:
+		r.list(t.List.GetElements())
+	case *blueprintpb.Expr_Map:
+		r.mapEntries(t.Map.GetEntries())
+	case *blueprintpb.Expr_File:
+		r.printf("file(%s)", strconv.Quote(t.File.GetPath()))
+	case *blueprintpb.Expr_ResourceIdentifier:
+		r.printf("%s %s ", t.ResourceIdentifier.GetType(), strconv.Quote(t.ResourceIdentifier.GetAlias()))
+		r.expr(t.ResourceIdentifier.GetValue())
+	case *blueprintpb.Expr_Resource:
+		r.printf("resource {")
+		r.indent++
+		r.field("identifier", t.Resource.GetIdentifier())
+		r.field("config", t.Resource.GetConfig())
+		r.indent--
+		r.newline()
+		r.printf("}")
+	case *blueprintpb.Expr_Provider:
+		r.provider(t.Provider)
+	case *blueprintpb.Expr_Get:
+		r.get(t.Get)
+	case *blueprintpb.Expr_GetRuntimeConfig:
+		r.printf("runtime_config")
+	case *blueprintpb.Expr_Build:
+		r.printf("build %s ", strconv.Quote(t.Build.GetAlias()))
+		r.blueprintSource(t.Build.GetSource())
+	case *blueprintpb.Expr_Concat:
+		r.call("concat", t.Concat.GetParts())
+	case *blueprintpb.Expr_Function:
+		r.call(t.Function.GetName(), t.Function.GetArgs())
+	case *blueprintpb.Expr_Secret:
+		switch src := t.Secret.GetSource().(type) {
+		case *blueprintpb.SecretExpr_Env:
+			r.printf("secret(env %s)", strconv.Quote(src.Env))
+		case *blueprintpb.SecretExpr_File:
+			r.printf("secret(file %s)", strconv.Quote(src.File))
+		default:
+			r.printf("secret(<unknown source %T>)", src)
+		}
+	default:
+		r.printf("<unknown expression %T>", t)
+	}
+}
+
+func (r *renderer) list(elements []*blueprintpb.Expr) {
+	if len(elements) == 0 {
+		r.printf("[]")
+		return
+	}
+
+	r.printf("[")
+	r.indent++
+	for _, e := range elements {
+		r.newline()
+		r.expr(e)
+	}
+	r.indent--
+	r.newline()
+	r.printf("]")
+}
+
+func (r *renderer) mapEntries(entries map[string]*blueprintpb.Expr) {
+	if len(entries) == 0 {
+		r.printf("{}")
+		return
+	}
+
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	r.printf("{")
+	r.indent++
+	for _, k := range keys {
+		r.field(k, entries[k])
+	}
+	r.indent--
+	r.newline()
+	r.printf("}")
+}
+
+// get renders a chain of gets rooted at an alias, such as
+// GetResource("bucket").Get("attrs").Get("name"), as get("bucket").attrs.name.
+func (r *renderer) get(g *blueprintpb.GetExpr) {
+	var names []string
+	for {
+		names = append(names, g.GetName())
+
+		next := g.GetObject().GetGet()
+		if next == nil {
+			break
+		}
+
+		g = next
+	}
+
+	switch g.GetObject().GetType().(type) {
+	case nil, *blueprintpb.Expr_Nil:
+	default:
+		r.expr(g.GetObject())
+		r.printf(".")
+	}
+
+	r.printf("get(%s)", strconv.Quote(names[len(names)-1]))
+	for i := len(names) - 2; i >= 0; i-- {
+		r.printf(".%s", names[i])
+	}
+}
+
+func (r *renderer) provider(p *blueprintpb.ProviderExpr) {
+	r.printf("provider(")
+	if p.GetName() != "" {
+		r.printf("%s, ", strconv.Quote(p.GetName()))
+	}
+	r.pluginSource(p.GetSource())
+	if p.GetAlias() != "" {
+		r.printf(", alias %s", strconv.Quote(p.GetAlias()))
+	}
+	if p.GetConfig() != nil {
+		r.printf(", config ")
+		r.expr(p.GetConfig())
+	}
+	r.printf(")")
+}
+
+func (r *renderer) pluginSource(s *blueprintpb.PluginSource) {
+	switch t := s.GetType().(type) {
+	case *blueprintpb.PluginSource_FilePath:
+		r.printf("file(%s)", strconv.Quote(t.FilePath.GetPath()))
+	case *blueprintpb.PluginSource_GitHubRelease:
+		gh := t.GitHubRelease
+		r.printf("github(%s", strconv.Quote(gh.GetRepoOwner()+"/"+gh.GetRepoName()+"/"+gh.GetName()))
+		if gh.GetVersion() != "" {
+			r.printf(", version %s", strconv.Quote(gh.GetVersion()))
+		}
+		if gh.GetSha256() != "" {
+			r.printf(", sha256 %s", strconv.Quote(gh.GetSha256()))
+		}
+		r.signature(gh.GetSignature())
+		r.printf(")")
+	case *blueprintpb.PluginSource_Http:
+		r.printf("http(%s, sha256 %s", strconv.Quote(t.Http.GetUrl()), strconv.Quote(t.Http.GetSha256()))
+		r.signature(t.Http.GetSignature())
+		r.printf(")")
+	case *blueprintpb.PluginSource_Oci:
+		r.printf("oci(%s, %s", strconv.Quote(t.Oci.GetReference()), strconv.Quote(t.Oci.GetDigest()))
+		r.signature(t.Oci.GetSignature())
+		r.printf(")")
+	default:
+		r.printf("<unknown plugin source %T>", t)
+	}
+}
+
+func (r *renderer) signature(sig *blueprintpb.PluginSignature) {
+	if sig == nil {
+		return
+	}
+
+	r.printf(", signature(identity %s, issuer %s", strconv.Quote(sig.GetIdentity()), strconv.Quote(sig.GetIssuer()))
+	if sig.GetBundle() != "" {
+		r.printf(", bundle %s", strconv.Quote(sig.GetBundle()))
+	}
+	r.printf(")")
+}
+
+func (r *renderer) blueprintSource(s *blueprintpb.BlueprintSource) {
+	switch t := s.GetType().(type) {
+	case *blueprintpb.BlueprintSource_FilePath:
+		r.printf("file(%s)", strconv.Quote(t.FilePath.GetPath()))
+	case *blueprintpb.BlueprintSource_Git:
+		git := t.Git
+		r.printf("git(%s, ref %s", strconv.Quote(git.GetRepo()), strconv.Quote(git.GetRef()))
+		if git.GetSubdir() != "" {
+			r.printf(", subdir %s", strconv.Quote(git.GetSubdir()))
+		}
+		r.printf(")")
+	default:
+		r.printf("<unknown blueprint source %T>", t)
+	}
+}
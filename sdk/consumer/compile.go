@@ -0,0 +1,266 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+)
+
+// Build is the entrypoint of a blueprint program run by the translator. It
+// reads the blueprint config from the file named by os.Args[0], compiles the
+// blueprint and writes it to the file named by os.Args[1].
+func Build(bf BlueprintFunc) {
+	configData, err := os.ReadFile(os.Args[0])
+	if err != nil {
+		log.Fatalf("error opening config file: %v", err)
+	}
+
+	configs, err := decodeConfigs(configData)
+	if err != nil {
+		log.Fatalf("error decoding config: %v", err)
+	}
+
+	p, err := Compile(bf, configs)
+	if err != nil {
+		log.Fatalf("error compiling blueprint: %v", err)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		log.Fatalf("error marshaling blueprint: %v", err)
+	}
+
+	if err := os.WriteFile(os.Args[1], data, 0666); err != nil {
+		log.Fatalf("error writing blueprint to file: %v", err)
+	}
+}
+
+// Compile runs bf with configs and converts the resulting blueprint into its
+// protocol representation. All statements are converted and checked for
+// duplicate aliases, conflicting provider configs, references to undeclared
+// aliases and dependency cycles.
+// Any errors are returned together as StmtErrors.
+func Compile(bf BlueprintFunc, configs []any) (*blueprintpb.Blueprint, error) {
+	bp, err := bf(configs...)
+	if err != nil {
+		return nil, fmt.Errorf("error building blueprint: %w", err)
+	}
+
+	p, err := bp.toProto()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkReferences(p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// StmtError is an error in a single statement of a blueprint.
+type StmtError struct {
+	// Index is the position of the statement in the compiled blueprint, i.e.
+	// after ForEach, When and components have been expanded. Origin tells
+	// which invocation generated it.
+	Index int
+	// Alias is the alias of the resource or build declared by the statement,
+	// if known.
+	Alias string
+	// Origin is the ForEach or When invocation that generated the statement,
+	// if any.
+	Origin string
+	Err    error
+}
+
+// Error returns the statement's error prefixed with its position, alias and
+// origin. Joined errors are reported one per line, each with the prefix.
+func (e StmtError) Error() string {
+	var labels []string
+	if e.Alias != "" {
+		labels = append(labels, e.Alias)
+	}
+
+	if e.Origin != "" {
+		labels = append(labels, "from "+e.Origin)
+	}
+
+	prefix := fmt.Sprintf("statement %d", e.Index)
+	if len(labels) > 0 {
+		prefix += " (" + strings.Join(labels, ", ") + ")"
+	}
+
+	joined, ok := e.Err.(interface{ Unwrap() []error })
+	if !ok {
+		return fmt.Sprintf("%s: %v", prefix, e.Err)
+	}
+
+	var lines []string
+	for _, err := range joined.Unwrap() {
+		lines = append(lines, fmt.Sprintf("%s: %v", prefix, err))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (e StmtError) Unwrap() error {
+	return e.Err
+}
+
+func decodeConfigs(data []byte) ([]any, error) {
+	configs := []*blueprintpb.Expr{}
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, err
+	}
+
+	configExprs := make([]any, len(configs))
+	for i, c := range configs {
+		config, err := fromProtoToExpr(c)
+		if err != nil {
+			return nil, fmt.Errorf("config %d: %v", i, err)
+		}
+
+		configExprs[i] = config
+	}
+
+	return configExprs, nil
+}
+
+func (b Blueprint) toProto() (*blueprintpb.Blueprint, error) {
+	p, _, err := b.compile()
+	return p, err
+}
+
+func stmtToProto(stmt any) (*blueprintpb.Stmt, error) {
+	switch s := stmt.(type) {
+	case resourceStmt:
+		res, err := toResourceExprProto(s.resource)
+		if err != nil {
+			return nil, fmt.Errorf("error converting resource: %v", err)
+		}
+
+		exists, err := toExprProto(s.exists)
+		if err != nil {
+			return nil, fmt.Errorf("error converting exists: %v", err)
+		}
+
+		provider, err := toProviderExprProto(s.provider)
+		if err != nil {
+			return nil, fmt.Errorf("error converting provider: %v", err)
+		}
+
+		opts, err := resourceOptionsToProto(s.opts)
+		if err != nil {
+			return nil, fmt.Errorf("error converting options: %v", err)
+		}
+
+		return &blueprintpb.Stmt{
+			Type: &blueprintpb.Stmt_Resource{
+				Resource: &blueprintpb.ResourceStmt{
+					Exists:   exists,
+					Resource: res,
+					Provider: provider,
+					Options:  opts,
+				},
+			},
+		}, nil
+	case buildStmt:
+		configs := make([]*blueprintpb.Expr, len(s.configs))
+		for i, c := range s.configs {
+			config, err := toExprProto(c)
+			if err != nil {
+				return nil, fmt.Errorf("error converting build config %d: %v", i, err)
+			}
+
+			configs[i] = config
+		}
+
+		runtimeConfig, err := toExprProto(s.runtimeConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error converting runtime config: %v", err)
+		}
+
+		r, err := blueprintSourceToProto(s.repo)
+		if err != nil {
+			return nil, fmt.Errorf("error converting repo: %v", err)
+		}
+
+		tr, err := pluginSourceToProto(s.translator.Source)
+		if err != nil {
+			return nil, fmt.Errorf("error converting translator repo: %v", err)
+		}
+
+		return &blueprintpb.Stmt{
+			Type: &blueprintpb.Stmt_Build{
+				Build: &blueprintpb.BuildStmt{
+					Translator: &blueprintpb.Translator{
+						Source: tr,
+						Name:   s.translator.Name,
+					},
+					Build: &blueprintpb.BuildExpr{
+						Alias:         s.alias,
+						Source:        r,
+						Config:        configs,
+						RuntimeConfig: runtimeConfig,
+					},
+				},
+			},
+		}, nil
+	case outputStmt:
+		value, err := toExprProto(s.value)
+		if err != nil {
+			return nil, fmt.Errorf("error converting output %q: %v", s.name, err)
+		}
+
+		return &blueprintpb.Stmt{
+			Type: &blueprintpb.Stmt_Output{
+				Output: &blueprintpb.OutputStmt{
+					Name:  s.name,
+					Value: value,
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid statement type: %T", stmt)
+	}
+}
+
+// stmtAlias returns the alias declared by stmt, or "" if it can't be
+// determined.
+func stmtAlias(stmt any) string {
+	switch s := stmt.(type) {
+	case originStmt:
+		return stmtAlias(s.stmt)
+	case resourceStmt:
+		return identifierAlias(s.resource.Identifier)
+	case buildStmt:
+		return s.alias
+	case componentStmt:
+		return s.alias
+	default:
+		return ""
+	}
+}
+
+func stmtOrigin(stmt any) string {
+	if s, ok := stmt.(originStmt); ok {
+		return s.origin
+	}
+
+	return ""
+}
+
+func identifierAlias(id any) string {
+	switch id := id.(type) {
+	case ResourceIdentifier:
+		return id.Alias
+	case exprConvertable:
+		return identifierAlias(id.ToExpr())
+	default:
+		return ""
+	}
+}
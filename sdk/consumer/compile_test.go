@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestStmtError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  StmtError
+		want string
+	}{
+		{
+			name: "index only",
+			err:  StmtError{Index: 2, Err: fmt.Errorf("boom")},
+			want: "statement 2: boom",
+		},
+		{
+			name: "alias",
+			err:  StmtError{Index: 2, Alias: "bucket", Err: fmt.Errorf("boom")},
+			want: "statement 2 (bucket): boom",
+		},
+		{
+			name: "alias and origin",
+			err:  StmtError{Index: 2, Alias: "bucket", Origin: "when(prod)", Err: fmt.Errorf("boom")},
+			want: "statement 2 (bucket, from when(prod)): boom",
+		},
+		{
+			name: "joined",
+			err:  StmtError{Index: 2, Alias: "bucket", Err: errors.Join(fmt.Errorf("first"), fmt.Errorf("second"))},
+			want: "statement 2 (bucket): first\nstatement 2 (bucket): second",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	buildErr := fmt.Errorf("bad config")
+	_, err := Compile(func(...any) (Blueprint, error) { return Blueprint{}, buildErr }, nil)
+	if !errors.Is(err, buildErr) {
+		t.Errorf("got %v, want it to wrap %v", err, buildErr)
+	}
+
+	_, err = Compile(testBlueprint(
+		testResource("a", nil),
+		testResource("b", func() {}),
+		testResource("c", GetResource("missing")),
+	), nil)
+
+	var stmtErr StmtError
+	if !errors.As(err, &stmtErr) {
+		t.Fatalf("got %v, want a StmtError", err)
+	}
+
+	if stmtErr.Index != 1 || stmtErr.Alias != "b" {
+		t.Errorf("got error for statement %d (%s), want statement 1 (b): %v", stmtErr.Index, stmtErr.Alias, err)
+	}
+}
+
+func testResource(alias string, config any, opts ...ResourceOption) func(Blueprint) Blueprint {
+	return func(b Blueprint) Blueprint {
+		return b.WithResource(
+			true,
+			Provider{Source: PluginSourceFilePath{Path: "provider"}},
+			Resource{
+				Identifier: ResourceIdentifier{
+					Alias:        alias,
+					ResourceType: "bucket",
+					Value:        map[string]any{"name": alias},
+				},
+				Config: config,
+			},
+			opts...,
+		)
+	}
+}
+
+func testBlueprint(stmts ...func(Blueprint) Blueprint) BlueprintFunc {
+	return func(...any) (Blueprint, error) {
+		b := Blueprint{}
+		for _, stmt := range stmts {
+			b = stmt(b)
+		}
+
+		return b, nil
+	}
+}
@@ -0,0 +1,481 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Component is a reusable fragment of a blueprint, such as a bucket together
+// with its IAM binding. Components are ordinary Go values that are expanded
+// in-process when the blueprint is compiled.
+//
+// The aliases declared by a component are namespaced by the alias it is added
+// with, e.g. resource "bucket" of component "assets" becomes "assets/bucket".
+// The same applies to the names of blueprint outputs declared by a component.
+// Values from the enclosing blueprint are passed in as inputs, and values
+// exported as outputs can be referenced with ComponentOutput. References in
+// inputs keep referring to the enclosing blueprint even if the component
+// declares the same alias.
+type Component struct {
+	// Inputs are the names of the inputs the component requires.
+	Inputs []string
+	// Outputs are the names of the outputs the component provides.
+	Outputs []string
+	// Build returns the component's fragment for the given inputs. Inputs
+	// that reference the enclosing blueprint, such as a Get or a nested
+	// ResourceIdentifier, are passed as a Get that can be used in the fragment
+	// or extended with further Gets, but not inspected. Other inputs, and maps
+	// and lists of them, are passed unchanged.
+	Build func(inputs map[string]any) (Fragment, error)
+}
+
+// Fragment is the result of building a component.
+type Fragment struct {
+	Blueprint Blueprint
+	// Outputs maps each declared output to its value. References to the
+	// component's own resources are namespaced along with the statements.
+	Outputs map[string]any
+}
+
+// WithComponent adds the statements of component c, built with inputs, to b
+// under alias.
+func (b Blueprint) WithComponent(alias string, c Component, inputs map[string]any) Blueprint {
+	b.stmts = append(b.stmts, componentStmt{
+		alias:     alias,
+		component: c,
+		inputs:    inputs,
+	})
+
+	return b
+}
+
+// ComponentOutput returns a reference to the output name of the component
+// added with alias. The reference is replaced with the output's value when
+// the blueprint is compiled.
+func ComponentOutput(alias, name string) Get {
+	return GetResource(alias).Get(name)
+}
+
+type componentStmt struct {
+	alias     string
+	component Component
+	inputs    map[string]any
+}
+
+// componentOutputs maps component aliases to their compiled outputs.
+type componentOutputs map[string]map[string]*blueprintpb.Expr
+
+// compile converts the statements of b, expanding components, and replaces
+// references to component outputs with their values.
+func (b Blueprint) compile() (*blueprintpb.Blueprint, componentOutputs, error) {
+	p := &blueprintpb.Blueprint{}
+	outputs := componentOutputs{}
+
+	var errs []error
+	for _, stmt := range b.stmts {
+		stmts, err := expandStmt(stmt, outputs)
+		if err != nil {
+			// A statement that fails to expand counts as a single statement of
+			// the compiled blueprint.
+			index := len(p.Stmts) + len(errs)
+			errs = append(errs, StmtError{Index: index, Alias: stmtAlias(stmt), Origin: stmtOrigin(stmt), Err: err})
+			continue
+		}
+
+		p.Stmts = append(p.Stmts, stmts...)
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	for i, stmt := range p.GetStmts() {
+		var err error
+		if alias := declaredAlias(stmt); outputs[alias] != nil {
+			err = fmt.Errorf("alias %q is already used by a component", alias)
+		} else {
+			err = walkStmtExprs(stmt, outputs.resolve)
+		}
+
+		if err != nil {
+			errs = append(errs, StmtError{Index: i, Alias: declaredAlias(stmt), Origin: stmt.GetOrigin(), Err: err})
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	return p, outputs, nil
+}
+
+func expandStmt(stmt any, outputs componentOutputs) ([]*blueprintpb.Stmt, error) {
+	switch s := stmt.(type) {
+	case originStmt:
+		stmts, err := expandStmt(s.stmt, outputs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range stmts {
+			p.Origin = joinOrigin(s.origin, p.GetOrigin())
+		}
+
+		return stmts, nil
+	case componentStmt:
+		if _, ok := outputs[s.alias]; ok {
+			return nil, fmt.Errorf("duplicate component alias %q", s.alias)
+		}
+
+		stmts, out, err := s.expand()
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", s.alias, err)
+		}
+
+		outputs[s.alias] = out
+
+		return stmts, nil
+	default:
+		p, err := stmtToProto(stmt)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*blueprintpb.Stmt{p}, nil
+	}
+}
+
+func joinOrigin(outer, inner string) string {
+	if inner == "" {
+		return outer
+	}
+
+	return outer + "/" + inner
+}
+
+// expand builds the component and returns its statements and outputs, with
+// the aliases it declares namespaced by the component alias.
+func (s componentStmt) expand() ([]*blueprintpb.Stmt, map[string]*blueprintpb.Expr, error) {
+	if err := checkNames("input", s.component.Inputs, s.inputs); err != nil {
+		return nil, nil, err
+	}
+
+	if s.component.Build == nil {
+		return nil, nil, fmt.Errorf("missing build function")
+	}
+
+	inputs, refs := s.placeholders()
+
+	frag, err := s.component.Build(inputs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkNames("output", s.component.Outputs, frag.Outputs); err != nil {
+		return nil, nil, err
+	}
+
+	p, nested, err := frag.Blueprint.compile()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outputs := map[string]*blueprintpb.Expr{}
+	for name, v := range frag.Outputs {
+		e, err := toExprProto(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("output %q: %v", name, err)
+		}
+
+		if err := walkExpr(e, nested.resolve); err != nil {
+			return nil, nil, fmt.Errorf("output %q: %v", name, err)
+		}
+
+		outputs[name] = e
+	}
+
+	declared := map[string]bool{}
+	for _, stmt := range p.GetStmts() {
+		if alias := declaredAlias(stmt); alias != "" {
+			declared[alias] = true
+		}
+	}
+
+	ns := namespacer{prefix: s.alias + "/", declared: declared}
+	for _, stmt := range p.GetStmts() {
+		if build := stmt.GetBuild().GetBuild(); build != nil {
+			build.Alias = ns.alias(build.GetAlias())
+		}
+
+		if out := stmt.GetOutput(); out != nil {
+			out.Name = ns.prefix + out.GetName()
+		}
+
+		if opts := stmt.GetResource().GetOptions(); opts != nil {
+			for i, alias := range opts.GetDependsOn() {
+				opts.DependsOn[i] = ns.alias(alias)
+			}
+		}
+
+		_ = walkStmtExprs(stmt, ns.expr)
+		_ = walkStmtExprs(stmt, refs.substitute)
+		stmt.Origin = joinOrigin(fmt.Sprintf("component(%s)", s.alias), stmt.GetOrigin())
+	}
+
+	for _, e := range outputs {
+		_ = walkExpr(e, ns.expr)
+		_ = walkExpr(e, refs.substitute)
+	}
+
+	return p.GetStmts(), outputs, nil
+}
+
+// inputRefs maps the placeholder aliases of a component's inputs to the
+// expressions they stand for.
+type inputRefs map[string]*blueprintpb.Expr
+
+// placeholders returns the inputs to build the component with. Inputs that
+// reference aliases are replaced with a Get of a placeholder alias, which
+// namespacing leaves alone, so that they can be substituted back afterwards
+// and keep referring to the enclosing blueprint.
+func (s componentStmt) placeholders() (map[string]any, inputRefs) {
+	refs := inputRefs{}
+	inputs := make(map[string]any, len(s.inputs))
+	for _, name := range sortedNames(s.inputs) {
+		inputs[name] = placeholder(s.inputs[name], "\x00"+s.alias+"."+name, refs)
+	}
+
+	return inputs, refs
+}
+
+func placeholder(v any, path string, refs inputRefs) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for _, k := range sortedNames(v) {
+			m[k] = placeholder(v[k], path+"."+k, refs)
+		}
+
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, el := range v {
+			l[i] = placeholder(el, fmt.Sprintf("%s[%d]", path, i), refs)
+		}
+
+		return l
+	}
+
+	// Values that can't be converted can't be used in the fragment either, so
+	// they are only of use to Build itself.
+	e, err := toExprProto(v)
+	if err != nil || len(exprReferences(e, nil)) == 0 {
+		return v
+	}
+
+	refs[path] = e
+
+	return GetResource(path)
+}
+
+// substitute replaces a placeholder alias with the input it stands for.
+func (r inputRefs) substitute(e *blueprintpb.Expr) error {
+	g := e.GetGet()
+	if g == nil || !isRootGet(g) {
+		return nil
+	}
+
+	if in, ok := r[g.GetName()]; ok {
+		e.Type = proto.Clone(in).(*blueprintpb.Expr).GetType()
+	}
+
+	return nil
+}
+
+// checkNames reports names in values that aren't declared and declared names
+// that are missing from values.
+func checkNames(kind string, declared []string, values map[string]any) error {
+	known := map[string]bool{}
+	var errs []error
+	for _, name := range declared {
+		known[name] = true
+		if _, ok := values[name]; !ok {
+			errs = append(errs, fmt.Errorf("missing %s %q", kind, name))
+		}
+	}
+
+	for _, name := range sortedNames(values) {
+		if !known[name] {
+			errs = append(errs, fmt.Errorf("unknown %s %q", kind, name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sortedNames(m map[string]any) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// namespacer prefixes the aliases declared by a component wherever they are
+// declared or referenced.
+type namespacer struct {
+	prefix   string
+	declared map[string]bool
+}
+
+func (n namespacer) alias(alias string) string {
+	if !n.declared[alias] {
+		return alias
+	}
+
+	return n.prefix + alias
+}
+
+func (n namespacer) expr(e *blueprintpb.Expr) error {
+	switch t := e.GetType().(type) {
+	case *blueprintpb.Expr_Get:
+		if isRootGet(t.Get) {
+			t.Get.Name = n.alias(t.Get.GetName())
+		}
+	case *blueprintpb.Expr_ResourceIdentifier:
+		t.ResourceIdentifier.Alias = n.alias(t.ResourceIdentifier.GetAlias())
+	case *blueprintpb.Expr_Build:
+		t.Build.Alias = n.alias(t.Build.GetAlias())
+	}
+
+	return nil
+}
+
+// resolve replaces a reference to a component output with the output's value.
+func (o componentOutputs) resolve(e *blueprintpb.Expr) error {
+	g := e.GetGet()
+	if g == nil {
+		return nil
+	}
+
+	if isRootGet(g) {
+		if _, ok := o[g.GetName()]; ok {
+			return fmt.Errorf("reference to component %q without an output", g.GetName())
+		}
+
+		return nil
+	}
+
+	root := g.GetObject().GetGet()
+	if root == nil || !isRootGet(root) {
+		return nil
+	}
+
+	outputs, ok := o[root.GetName()]
+	if !ok {
+		return nil
+	}
+
+	out, ok := outputs[g.GetName()]
+	if !ok {
+		return fmt.Errorf("component %q has no output %q", root.GetName(), g.GetName())
+	}
+
+	e.Type = proto.Clone(out).(*blueprintpb.Expr).GetType()
+
+	return nil
+}
+
+func isRootGet(g *blueprintpb.GetExpr) bool {
+	switch g.GetObject().GetType().(type) {
+	case nil, *blueprintpb.Expr_Nil:
+		return true
+	default:
+		return false
+	}
+}
+
+// walkStmtExprs calls fn for every expression in stmt, parents before their
+// children. fn may replace the expression's contents in place.
+func walkStmtExprs(stmt *blueprintpb.Stmt, fn func(*blueprintpb.Expr) error) error {
+	var exprs []*blueprintpb.Expr
+	switch s := stmt.GetType().(type) {
+	case *blueprintpb.Stmt_Resource:
+		exprs = []*blueprintpb.Expr{
+			s.Resource.GetExists(),
+			s.Resource.GetProvider().GetConfig(),
+			s.Resource.GetResource().GetIdentifier(),
+			s.Resource.GetResource().GetConfig(),
+		}
+	case *blueprintpb.Stmt_Build:
+		exprs = append(exprs, s.Build.GetBuild().GetConfig()...)
+		exprs = append(exprs, s.Build.GetBuild().GetRuntimeConfig())
+	case *blueprintpb.Stmt_Output:
+		exprs = []*blueprintpb.Expr{s.Output.GetValue()}
+	}
+
+	var errs []error
+	for _, e := range exprs {
+		if err := walkExpr(e, fn); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func walkExpr(e *blueprintpb.Expr, fn func(*blueprintpb.Expr) error) error {
+	if e == nil {
+		return nil
+	}
+
+	if err := fn(e); err != nil {
+		return err
+	}
+
+	var children []*blueprintpb.Expr
+	switch t := e.GetType().(type) {
+	case *blueprintpb.Expr_Get:
+		children = []*blueprintpb.Expr{t.Get.GetObject()}
+	case *blueprintpb.Expr_ResourceIdentifier:
+		children = []*blueprintpb.Expr{t.ResourceIdentifier.GetValue()}
+	case *blueprintpb.Expr_Resource:
+		children = []*blueprintpb.Expr{t.Resource.GetIdentifier(), t.Resource.GetConfig()}
+	case *blueprintpb.Expr_Provider:
+		children = []*blueprintpb.Expr{t.Provider.GetConfig()}
+	case *blueprintpb.Expr_List:
+		children = t.List.GetElements()
+	case *blueprintpb.Expr_Map:
+		keys := make([]string, 0, len(t.Map.GetEntries()))
+		for k := range t.Map.GetEntries() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			children = append(children, t.Map.GetEntries()[k])
+		}
+	case *blueprintpb.Expr_Build:
+		children = append(children, t.Build.GetConfig()...)
+		children = append(children, t.Build.GetRuntimeConfig())
+	case *blueprintpb.Expr_Concat:
+		children = t.Concat.GetParts()
+	case *blueprintpb.Expr_Function:
+		children = t.Function.GetArgs()
+	}
+
+	var errs []error
+	for _, c := range children {
+		if err := walkExpr(c, fn); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,253 @@
+package sdk_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+	"github.com/alchematik/athanor-go/sdk/consumer/blueprinttest"
+)
+
+var provider = sdk.Provider{Source: sdk.PluginSourceFilePath{Path: "provider"}}
+
+func bucket(alias string, config any) sdk.Resource {
+	return sdk.Resource{
+		Identifier: sdk.ResourceIdentifier{Alias: alias, ResourceType: "bucket", Value: alias},
+		Config:     config,
+	}
+}
+
+// replica declares its own "bucket" that copies the bucket named by its
+// "source" input.
+var replica = sdk.Component{
+	Inputs:  []string{"source", "region"},
+	Outputs: []string{"name"},
+	Build: func(inputs map[string]any) (sdk.Fragment, error) {
+		region, ok := inputs["region"].(string)
+		if !ok {
+			return sdk.Fragment{}, nil
+		}
+
+		b := sdk.Blueprint{}.
+			WithResource(true, provider, bucket("bucket", map[string]any{
+				"source": inputs["source"],
+				"region": region,
+			})).
+			WithResource(true, provider, bucket("policy", map[string]any{
+				"bucket": sdk.GetResource("bucket").Get("attrs").Get("name"),
+			}))
+
+		return sdk.Fragment{
+			Blueprint: b,
+			Outputs:   map[string]any{"name": sdk.GetResource("bucket").Get("attrs").Get("name")},
+		}, nil
+	},
+}
+
+func TestComponent(t *testing.T) {
+	bp := blueprinttest.Compile(t, func(...any) (sdk.Blueprint, error) {
+		b := sdk.Blueprint{}.
+			WithResource(true, provider, bucket("bucket", nil)).
+			WithComponent("replica", replica, map[string]any{
+				"source": sdk.GetResource("bucket").Get("attrs").Get("name"),
+				"region": "us-east1",
+			}).
+			WithOutput("replica", sdk.ComponentOutput("replica", "name"))
+
+		return b, nil
+	})
+
+	want := `resource "bucket" {
+  exists: true
+  provider: provider(file("provider"))
+  identifier: bucket "bucket" "bucket"
+  config: nil
+}
+
+resource "replica/bucket" {
+  origin: "component(replica)"
+  exists: true
+  provider: provider(file("provider"))
+  identifier: bucket "replica/bucket" "bucket"
+  config: {
+    region: "us-east1"
+    source: get("bucket").attrs.name
+  }
+}
+
+resource "replica/policy" {
+  origin: "component(replica)"
+  exists: true
+  provider: provider(file("provider"))
+  identifier: bucket "replica/policy" "policy"
+  config: {
+    bucket: get("replica/bucket").attrs.name
+  }
+}
+
+output "replica" {
+  value: get("replica/bucket").attrs.name
+}
+`
+
+	if got := blueprinttest.Render(bp); got != want {
+		t.Errorf("blueprint doesn't match (-want +got):\n%s", blueprinttest.Diff(want, got))
+	}
+}
+
+func TestComponentInputs(t *testing.T) {
+	var got map[string]any
+	c := sdk.Component{
+		Inputs: []string{"bucket", "id", "settings"},
+		Build: func(inputs map[string]any) (sdk.Fragment, error) {
+			got = inputs
+			b := sdk.Blueprint{}.
+				WithResource(true, provider, bucket("bucket", map[string]any{
+					"url":      inputs["bucket"].(sdk.Get).Get("url"),
+					"id":       inputs["id"],
+					"settings": inputs["settings"],
+				}))
+
+			return sdk.Fragment{Blueprint: b}, nil
+		},
+	}
+
+	bp := blueprinttest.Compile(t, func(...any) (sdk.Blueprint, error) {
+		b := sdk.Blueprint{}.
+			WithResource(true, provider, bucket("bucket", nil)).
+			WithComponent("copy", c, map[string]any{
+				"bucket": sdk.GetResource("bucket").Get("attrs"),
+				"id":     sdk.ResourceIdentifier{Alias: "bucket", ResourceType: "bucket", Value: "bucket"},
+				"settings": map[string]any{
+					"tier":  "cold",
+					"peers": []any{"a", sdk.GetResource("bucket")},
+				},
+			})
+
+		return b, nil
+	})
+
+	settings := got["settings"].(map[string]any)
+	if settings["tier"] != "cold" {
+		t.Errorf("got literal input %v, want it passed unchanged", settings["tier"])
+	}
+
+	if peers := settings["peers"].([]any); peers[0] != "a" {
+		t.Errorf("got literal list element %v, want it passed unchanged", peers[0])
+	}
+
+	want := `  config: {
+    id: bucket "bucket" "bucket"
+    settings: {
+      peers: [
+        "a"
+        get("bucket")
+      ]
+      tier: "cold"
+    }
+    url: get("bucket").attrs.url
+  }`
+	if rendered := blueprinttest.Render(bp); !strings.Contains(rendered, want) {
+		t.Errorf("got:\n%s\nwant it to contain:\n%s", rendered, want)
+	}
+}
+
+func TestComponentErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs map[string]any
+		output sdk.Get
+		want   string
+	}{
+		{
+			name:   "missing input",
+			inputs: map[string]any{"source": "name"},
+			output: sdk.ComponentOutput("replica", "name"),
+			want:   `missing input "region"`,
+		},
+		{
+			name:   "unknown input",
+			inputs: map[string]any{"source": "name", "region": "us-east1", "zone": "a"},
+			output: sdk.ComponentOutput("replica", "name"),
+			want:   `unknown input "zone"`,
+		},
+		{
+			name:   "unknown output",
+			inputs: map[string]any{"source": "name", "region": "us-east1"},
+			output: sdk.ComponentOutput("replica", "url"),
+			want:   `component "replica" has no output "url"`,
+		},
+		{
+			name:   "component without output",
+			inputs: map[string]any{"source": "name", "region": "us-east1"},
+			output: sdk.GetResource("replica"),
+			want:   `reference to component "replica" without an output`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sdk.Compile(func(...any) (sdk.Blueprint, error) {
+				b := sdk.Blueprint{}.
+					WithComponent("replica", replica, tt.inputs).
+					WithOutput("out", tt.output)
+
+				return b, nil
+			}, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestComponentErrorIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		last func(sdk.Blueprint) sdk.Blueprint
+		want string
+	}{
+		{
+			name: "conversion",
+			last: func(b sdk.Blueprint) sdk.Blueprint {
+				return b.WithResource(true, provider, bucket("bad", func() {}))
+			},
+			want: "statement 4 (bad): ",
+		},
+		{
+			name: "component output",
+			last: func(b sdk.Blueprint) sdk.Blueprint {
+				return b.WithOutput("url", sdk.ComponentOutput("replica", "url"))
+			},
+			want: `statement 4: component "replica" has no output "url"`,
+		},
+		{
+			name: "reference",
+			last: func(b sdk.Blueprint) sdk.Blueprint {
+				return b.WithResource(true, provider, bucket("dangling", sdk.GetResource("missing")))
+			},
+			want: `statement 4 (dangling): reference to undeclared alias "missing"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The ForEach and the component each expand to two statements, so
+			// the last statement is statement 4 of the compiled blueprint.
+			_, err := sdk.Compile(func(...any) (sdk.Blueprint, error) {
+				b := sdk.ForEach(sdk.Blueprint{}, "buckets", []string{"a", "b"}, func(_ int, alias string) sdk.Blueprint {
+					return sdk.Blueprint{}.WithResource(true, provider, bucket(alias, nil))
+				})
+				b = b.WithComponent("replica", replica, map[string]any{
+					"source": sdk.GetResource("a").Get("attrs").Get("name"),
+					"region": "us-east1",
+				})
+
+				return tt.last(b), nil
+			}, nil)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+				t.Errorf("got error %v, want it to start with %q", err, tt.want)
+			}
+		})
+	}
+}
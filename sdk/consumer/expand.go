@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"fmt"
+)
+
+// originStmt is a statement generated by ForEach or When. origin describes
+// the helper invocation, outermost first.
+type originStmt struct {
+	origin string
+	stmt   any
+}
+
+// ForEach calls fn for each item and adds the statements of the returned
+// blueprints to b, in order. Each statement is tagged with the origin
+// "foreach(name)[i]". Aliases of generated statements must be unique; this
+// is checked by Compile.
+func ForEach[T any](b Blueprint, name string, items []T, fn func(i int, item T) Blueprint) Blueprint {
+	for i, item := range items {
+		b = b.withStmtsFrom(fmt.Sprintf("foreach(%s)[%d]", name, i), fn(i, item))
+	}
+
+	return b
+}
+
+// When adds the statements of the blueprint returned by fn to b if cond is
+// true. Each statement is tagged with the origin "when(name)".
+func When(b Blueprint, name string, cond bool, fn func() Blueprint) Blueprint {
+	if !cond {
+		return b
+	}
+
+	return b.withStmtsFrom(fmt.Sprintf("when(%s)", name), fn())
+}
+
+func (b Blueprint) withStmtsFrom(origin string, from Blueprint) Blueprint {
+	for _, stmt := range from.stmts {
+		if s, ok := stmt.(originStmt); ok {
+			stmt = originStmt{origin: origin + "/" + s.origin, stmt: s.stmt}
+		} else {
+			stmt = originStmt{origin: origin, stmt: stmt}
+		}
+
+		b.stmts = append(b.stmts, stmt)
+	}
+
+	return b
+}
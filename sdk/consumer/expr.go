@@ -0,0 +1,34 @@
+package sdk
+
+// Expr is a typed blueprint expression. It holds either a literal value of type
+// T or a reference to a value of type T, such as a resource attribute. The zero
+// Expr is unset and is converted to nil.
+type Expr[T any] struct {
+	expr any
+}
+
+// Literal returns an expression for the value v.
+func Literal[T any](v T) Expr[T] {
+	return Expr[T]{expr: v}
+}
+
+// Reference returns an expression for the value g refers to. The caller
+// asserts that the referenced value has type T; generated resource packages
+// provide accessors that do so based on the provider schema.
+func Reference[T any](g Get) Expr[T] {
+	return Expr[T]{expr: g}
+}
+
+func (e Expr[T]) ToExpr() any {
+	return e.expr
+}
+
+// Identifier is implemented by resource identifiers: ResourceIdentifier and
+// the identifier types generated for each resource.
+type Identifier interface {
+	Identifier() ResourceIdentifier
+}
+
+func (id ResourceIdentifier) Identifier() ResourceIdentifier {
+	return id
+}
@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Concat is a string built from the string values of Parts. It is evaluated
+// by the engine once the expressions it references are resolved.
+type Concat struct {
+	Parts []any
+}
+
+// FunctionCall is a call to a function that is evaluated by the engine once
+// the expressions it references are resolved. Name must be one of the
+// functions the engine supports: join, lower, base64, sha256 or jsonencode.
+// Prefer the typed constructors, such as Join and Lower.
+type FunctionCall struct {
+	Name string
+	Args []any
+}
+
+// functionArity maps the functions the engine supports to their number of
+// args.
+var functionArity = map[string]int{
+	"join":       2,
+	"lower":      1,
+	"base64":     1,
+	"sha256":     1,
+	"jsonencode": 1,
+}
+
+func (c FunctionCall) check() error {
+	arity, ok := functionArity[c.Name]
+	if !ok {
+		return fmt.Errorf("unknown function %q", c.Name)
+	}
+
+	if len(c.Args) != arity {
+		return fmt.Errorf("function %s takes %d args, got %d", c.Name, arity, len(c.Args))
+	}
+
+	return nil
+}
+
+// Format returns a string expression built from format and args, e.g.
+// Format("gs://%s/path", bucket.Ref("b").Attrs().Name()). Each %s or %v is
+// replaced by the next arg and %% is a literal percent sign. The result is
+// encoded as a Concat.
+func Format(format string, args ...any) Expr[string] {
+	return Expr[string]{expr: formatExpr{format: format, args: args}}
+}
+
+// Join returns list's elements joined by sep.
+func Join(sep, list any) Expr[string] {
+	return call("join", sep, list)
+}
+
+// Lower returns s in lower case.
+func Lower(s any) Expr[string] {
+	return call("lower", s)
+}
+
+// Base64 returns the standard base64 encoding of s.
+func Base64(s any) Expr[string] {
+	return call("base64", s)
+}
+
+// SHA256 returns the hex encoded SHA-256 hash of s.
+func SHA256(s any) Expr[string] {
+	return call("sha256", s)
+}
+
+// JSONEncode returns v encoded as JSON.
+func JSONEncode(v any) Expr[string] {
+	return call("jsonencode", v)
+}
+
+func call(name string, args ...any) Expr[string] {
+	return Expr[string]{expr: FunctionCall{Name: name, Args: args}}
+}
+
+type formatExpr struct {
+	format string
+	args   []any
+}
+
+// concat splits the format string into literal parts and args.
+func (f formatExpr) concat() (Concat, error) {
+	var parts []any
+	var lit strings.Builder
+	next := 0
+	for i := 0; i < len(f.format); i++ {
+		c := f.format[i]
+		if c != '%' {
+			lit.WriteByte(c)
+			continue
+		}
+
+		if i+1 == len(f.format) {
+			return Concat{}, fmt.Errorf("format %q ends with %%", f.format)
+		}
+
+		i++
+		switch f.format[i] {
+		case '%':
+			lit.WriteByte('%')
+		case 's', 'v':
+			if next == len(f.args) {
+				return Concat{}, fmt.Errorf("format %q has more verbs than args", f.format)
+			}
+
+			if lit.Len() > 0 {
+				parts = append(parts, lit.String())
+				lit.Reset()
+			}
+
+			parts = append(parts, f.args[next])
+			next++
+		default:
+			return Concat{}, fmt.Errorf("format %q: unsupported verb %%%c", f.format, f.format[i])
+		}
+	}
+
+	if next < len(f.args) {
+		return Concat{}, fmt.Errorf("format %q has %d args but %d verbs", f.format, len(f.args), next)
+	}
+
+	if lit.Len() > 0 {
+		parts = append(parts, lit.String())
+	}
+
+	return Concat{Parts: parts}, nil
+}
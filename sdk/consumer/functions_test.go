@@ -0,0 +1,93 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestFunctionCall(t *testing.T) {
+	tests := []struct {
+		name string
+		expr any
+		want string
+	}{
+		{name: "join", expr: Join(",", []any{"a", "b"})},
+		{name: "lower", expr: Lower(GetResource("bucket").Get("attrs").Get("name"))},
+		{name: "base64", expr: Base64("a")},
+		{name: "sha256", expr: SHA256("a")},
+		{name: "jsonencode", expr: JSONEncode(map[string]any{"a": 1})},
+		{
+			name: "unknown function",
+			expr: FunctionCall{Name: "exec", Args: []any{"rm -rf /"}},
+			want: `unknown function "exec"`,
+		},
+		{
+			name: "wrong number of args",
+			expr: FunctionCall{Name: "join", Args: []any{","}},
+			want: "function join takes 2 args, got 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toExprProto(tt.expr)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	name := GetResource("bucket").Get("attrs").Get("name")
+
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+		want   []any
+		err    string
+	}{
+		{name: "literal", format: "bucket", want: []any{"bucket"}},
+		{name: "verbs", format: "gs://%s/%v", args: []any{name, "path"}, want: []any{"gs://", name, "/", "path"}},
+		{name: "percent", format: "100%% %s", args: []any{"done"}, want: []any{"100% ", "done"}},
+		{name: "unsupported verb", format: "%d", args: []any{1}, err: `format "%d": unsupported verb %d`},
+		{name: "missing args", format: "%s-%s", args: []any{"a"}, err: `format "%s-%s" has more verbs than args`},
+		{name: "extra args", format: "%s", args: []any{"a", "b"}, err: `format "%s" has 2 args but 1 verbs`},
+		{name: "trailing percent", format: "a%", err: `format "a%" ends with %`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := formatExpr{format: tt.format, args: tt.args}.concat()
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("got error %v, want %q", err, tt.err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(c.Parts) != len(tt.want) {
+				t.Fatalf("got parts %v, want %v", c.Parts, tt.want)
+			}
+
+			for i := range c.Parts {
+				if c.Parts[i] != tt.want[i] {
+					t.Errorf("got parts %v, want %v", c.Parts, tt.want)
+				}
+			}
+		})
+	}
+}
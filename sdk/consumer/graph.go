@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+)
+
+// Graph is the dependency graph of a compiled blueprint. Resources and builds
+// are nodes, and an edge from one node to another means the first depends on
+// the second through a nested identifier or a Get reference.
+type Graph struct {
+	Nodes    []GraphNode    `json:"nodes"`
+	Edges    []GraphEdge    `json:"edges"`
+	Clusters []GraphCluster `json:"clusters,omitempty"`
+}
+
+type GraphNode struct {
+	// ID is the node's alias prefixed by the aliases of the builds it's nested
+	// in, e.g. "network/vpc".
+	ID    string `json:"id"`
+	Alias string `json:"alias"`
+	// Type is the resource type, or "build" for builds.
+	Type string `json:"type"`
+	// Cluster is the ID of the build cluster the node belongs to, if any.
+	Cluster string `json:"cluster,omitempty"`
+}
+
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GraphCluster groups the nodes of a build's sub-blueprint. Its ID is the ID
+// of the build's node.
+type GraphCluster struct {
+	ID     string `json:"id"`
+	Parent string `json:"parent,omitempty"`
+}
+
+// BlueprintGraph extracts the dependency graph of bp. Sub-blueprints of
+// builds can be included as clusters by passing their compiled blueprints in
+// subs, keyed by build ID: the build's alias, prefixed by the aliases of any
+// enclosing builds, e.g. "network" and "network/subnets".
+func BlueprintGraph(bp *blueprintpb.Blueprint, subs map[string]*blueprintpb.Blueprint) Graph {
+	g := Graph{}
+	g.add(bp, subs, "")
+	return g
+}
+
+func (g *Graph) add(bp *blueprintpb.Blueprint, subs map[string]*blueprintpb.Blueprint, cluster string) {
+	prefix := ""
+	if cluster != "" {
+		prefix = cluster + "/"
+	}
+
+	stmts := bp.GetStmts()
+	aliases := map[string]int{}
+	for i, stmt := range stmts {
+		alias := declaredAlias(stmt)
+		if alias == "" {
+			continue
+		}
+
+		if _, ok := aliases[alias]; !ok {
+			aliases[alias] = i
+		}
+	}
+
+	for i, stmt := range stmts {
+		alias := declaredAlias(stmt)
+		if alias == "" || aliases[alias] != i {
+			continue
+		}
+
+		id := prefix + alias
+		switch s := stmt.GetType().(type) {
+		case *blueprintpb.Stmt_Resource:
+			g.Nodes = append(g.Nodes, GraphNode{
+				ID:      id,
+				Alias:   alias,
+				Type:    s.Resource.GetResource().GetIdentifier().GetResourceIdentifier().GetType(),
+				Cluster: cluster,
+			})
+		case *blueprintpb.Stmt_Build:
+			sub, ok := subs[id]
+			if !ok {
+				g.Nodes = append(g.Nodes, GraphNode{ID: id, Alias: alias, Type: "build", Cluster: cluster})
+				continue
+			}
+
+			g.Clusters = append(g.Clusters, GraphCluster{ID: id, Parent: cluster})
+			g.Nodes = append(g.Nodes, GraphNode{ID: id, Alias: alias, Type: "build", Cluster: id})
+			g.add(sub, subs, id)
+		}
+	}
+
+	for i, deps := range dependencies(bp, aliases) {
+		alias := declaredAlias(stmts[i])
+		if alias == "" || aliases[alias] != i {
+			continue
+		}
+
+		for _, j := range deps {
+			g.Edges = append(g.Edges, GraphEdge{
+				From: prefix + alias,
+				To:   prefix + declaredAlias(stmts[j]),
+			})
+		}
+	}
+}
+
+// JSON returns the graph encoded as indented JSON.
+func (g Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// DOT returns the graph in Graphviz DOT format, with each build that has a
+// sub-blueprint drawn as a cluster.
+func (g Graph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph blueprint {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	g.writeDOTCluster(&sb, "", 1)
+
+	for _, e := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -> %s;\n", strconv.Quote(e.From), strconv.Quote(e.To))
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func (g Graph) writeDOTCluster(sb *strings.Builder, cluster string, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	for _, n := range g.Nodes {
+		if n.Cluster != cluster {
+			continue
+		}
+
+		shape := "ellipse"
+		if n.Type == "build" {
+			shape = "box"
+		}
+
+		fmt.Fprintf(sb, "%s%s [label=%s, shape=%s];\n", indent, strconv.Quote(n.ID), strconv.Quote(n.Alias+"\n"+n.Type), shape)
+	}
+
+	for _, c := range g.Clusters {
+		if c.Parent != cluster {
+			continue
+		}
+
+		fmt.Fprintf(sb, "%ssubgraph %s {\n", indent, strconv.Quote("cluster_"+c.ID))
+		fmt.Fprintf(sb, "%s  label=%s;\n", indent, strconv.Quote(c.ID))
+		g.writeDOTCluster(sb, c.ID, depth+1)
+		fmt.Fprintf(sb, "%s}\n", indent)
+	}
+}
@@ -0,0 +1,189 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+)
+
+func TestBlueprintGraph(t *testing.T) {
+	g := BlueprintGraph(graphBlueprint(t), graphSubs(t))
+
+	wantNodes := []GraphNode{
+		{ID: "a", Alias: "a", Type: "bucket"},
+		{ID: "b", Alias: "b", Type: "bucket"},
+		{ID: "c", Alias: "c", Type: "bucket"},
+		{ID: "d", Alias: "d", Type: "bucket"},
+		{ID: "net", Alias: "net", Type: "build", Cluster: "net"},
+		{ID: "net/vpc", Alias: "vpc", Type: "bucket", Cluster: "net"},
+		{ID: "net/subnets", Alias: "subnets", Type: "build", Cluster: "net/subnets"},
+		{ID: "net/subnets/subnet", Alias: "subnet", Type: "bucket", Cluster: "net/subnets"},
+		{ID: "net/subnets/route", Alias: "route", Type: "bucket", Cluster: "net/subnets"},
+		{ID: "site", Alias: "site", Type: "build"},
+	}
+	if !reflect.DeepEqual(g.Nodes, wantNodes) {
+		t.Errorf("got nodes %+v, want %+v", g.Nodes, wantNodes)
+	}
+
+	// The second "a" is skipped, so there is no edge from it to "d".
+	wantEdges := []GraphEdge{
+		{From: "net/subnets/route", To: "net/subnets/subnet"},
+		{From: "b", To: "a"},
+		{From: "c", To: "a"},
+		{From: "d", To: "b"},
+		{From: "net", To: "c"},
+	}
+	if !reflect.DeepEqual(g.Edges, wantEdges) {
+		t.Errorf("got edges %+v, want %+v", g.Edges, wantEdges)
+	}
+
+	wantClusters := []GraphCluster{
+		{ID: "net"},
+		{ID: "net/subnets", Parent: "net"},
+	}
+	if !reflect.DeepEqual(g.Clusters, wantClusters) {
+		t.Errorf("got clusters %+v, want %+v", g.Clusters, wantClusters)
+	}
+}
+
+func TestGraphDOT(t *testing.T) {
+	got := BlueprintGraph(graphBlueprint(t), graphSubs(t)).DOT()
+	want := `digraph blueprint {
+  rankdir=LR;
+  "a" [label="a\nbucket", shape=ellipse];
+  "b" [label="b\nbucket", shape=ellipse];
+  "c" [label="c\nbucket", shape=ellipse];
+  "d" [label="d\nbucket", shape=ellipse];
+  "site" [label="site\nbuild", shape=box];
+  subgraph "cluster_net" {
+    label="net";
+    "net" [label="net\nbuild", shape=box];
+    "net/vpc" [label="vpc\nbucket", shape=ellipse];
+    subgraph "cluster_net/subnets" {
+      label="net/subnets";
+      "net/subnets" [label="subnets\nbuild", shape=box];
+      "net/subnets/subnet" [label="subnet\nbucket", shape=ellipse];
+      "net/subnets/route" [label="route\nbucket", shape=ellipse];
+    }
+  }
+  "net/subnets/route" -> "net/subnets/subnet";
+  "b" -> "a";
+  "c" -> "a";
+  "d" -> "b";
+  "net" -> "c";
+}
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestGraphJSON(t *testing.T) {
+	g := BlueprintGraph(compileTestBlueprint(t,
+		testResource("a", nil),
+		testBuild("net", GetResource("a")),
+	), map[string]*blueprintpb.Blueprint{
+		"net": compileTestBlueprint(t, testResource("vpc", nil)),
+	})
+
+	got, err := g.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+  "nodes": [
+    {
+      "id": "a",
+      "alias": "a",
+      "type": "bucket"
+    },
+    {
+      "id": "net",
+      "alias": "net",
+      "type": "build",
+      "cluster": "net"
+    },
+    {
+      "id": "net/vpc",
+      "alias": "vpc",
+      "type": "bucket",
+      "cluster": "net"
+    }
+  ],
+  "edges": [
+    {
+      "from": "net",
+      "to": "a"
+    }
+  ],
+  "clusters": [
+    {
+      "id": "net"
+    }
+  ]
+}`
+	if string(got) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// graphBlueprint returns a blueprint with an edge for each kind of reference,
+// a duplicate alias, and builds with and without a sub-blueprint.
+func graphBlueprint(t *testing.T) *blueprintpb.Blueprint {
+	return compileTestBlueprint(t,
+		testResource("a", nil),
+		testResource("b", GetResource("a").Get("attrs")),
+		testResource("c", ResourceIdentifier{Alias: "a", ResourceType: "bucket", Value: "a"}),
+		testResource("d", nil, DependsOn("b")),
+		testResource("a", GetResource("d")),
+		testBuild("net", GetResource("c").Get("attrs")),
+		testBuild("site", nil),
+	)
+}
+
+// graphSubs returns the sub-blueprint of the "net" build of graphBlueprint,
+// and of the "subnets" build nested in it.
+func graphSubs(t *testing.T) map[string]*blueprintpb.Blueprint {
+	return map[string]*blueprintpb.Blueprint{
+		"net": compileTestBlueprint(t,
+			testResource("vpc", nil),
+			testBuild("subnets", nil),
+		),
+		"net/subnets": compileTestBlueprint(t,
+			testResource("subnet", nil),
+			testResource("route", GetResource("subnet").Get("attrs")),
+		),
+	}
+}
+
+// compileTestBlueprint converts stmts without checking references, so that
+// the blueprint may contain duplicate aliases.
+func compileTestBlueprint(t *testing.T, stmts ...func(Blueprint) Blueprint) *blueprintpb.Blueprint {
+	t.Helper()
+
+	b, err := testBlueprint(stmts...)()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := b.toProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func testBuild(alias string, config any) func(Blueprint) Blueprint {
+	return func(b Blueprint) Blueprint {
+		return b.WithBuild(
+			alias,
+			BlueprintSourceFilePath{Path: alias},
+			Translator{Source: PluginSourceFilePath{Path: "translator"}, Name: "go"},
+			nil,
+			config,
+		)
+	}
+}
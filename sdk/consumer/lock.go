@@ -0,0 +1,184 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const lockFileVersion = 1
+
+// LockFile records the resolved digests of plugin sources, so that builds
+// keep executing the binaries that were reviewed when the lock was written.
+// Plugins are keyed by PluginSourceKey.
+type LockFile struct {
+	Version int                     `json:"version"`
+	Plugins map[string]LockedPlugin `json:"plugins"`
+}
+
+type LockedPlugin struct {
+	// Version is the release or tag the digest was resolved from. It is only
+	// set for GitHub releases and OCI references.
+	Version string `json:"version,omitempty"`
+	// SHA256 is the hex encoded SHA-256 digest of the plugin.
+	SHA256 string `json:"sha256"`
+}
+
+// ReadLockFile reads the lock file at path.
+func ReadLockFile(path string) (LockFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return LockFile{}, err
+	}
+
+	var l LockFile
+	if err := json.Unmarshal(data, &l); err != nil {
+		return LockFile{}, fmt.Errorf("parsing lock file %s: %v", path, err)
+	}
+
+	if l.Version != lockFileVersion {
+		return LockFile{}, fmt.Errorf("lock file %s: unsupported version %d", path, l.Version)
+	}
+
+	for key, p := range l.Plugins {
+		if !isSHA256(p.SHA256) {
+			return LockFile{}, fmt.Errorf("lock file %s: plugin %s: invalid sha256 %q", path, key, p.SHA256)
+		}
+	}
+
+	return l, nil
+}
+
+// WriteLockFile writes l to path. Plugins are sorted by key, so the same lock
+// always produces the same file.
+func WriteLockFile(path string, l LockFile) error {
+	l.Version = lockFileVersion
+	if l.Plugins == nil {
+		l.Plugins = map[string]LockedPlugin{}
+	}
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0666)
+}
+
+// PluginSourceKey returns the key a plugin source is locked under: its
+// location without any version or digest, e.g. the repository of an OCI
+// reference without its tag or digest. Plugins from file paths are built
+// locally and can't be locked.
+func PluginSourceKey(s PluginSource) (string, error) {
+	switch s := s.(type) {
+	case PluginSourceGitHubRelease:
+		return "github.com/" + s.RepoOwner + "/" + s.RepoName + "/" + s.Name, nil
+	case PluginSourceHTTP:
+		return s.URL, nil
+	case PluginSourceOCI:
+		repo, _, _ := splitOCIReference(s.Reference)
+		return repo, nil
+	default:
+		return "", fmt.Errorf("plugin source %T can't be locked", s)
+	}
+}
+
+// splitOCIReference splits an OCI reference such as
+// "registry.example.com:5000/plugins/aws:v1.2.0@sha256:..." into its
+// repository, tag and digest.
+func splitOCIReference(ref string) (repo, tag, digest string) {
+	repo, digest, _ = strings.Cut(ref, "@")
+
+	// A colon before the last slash separates the registry's port.
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo, tag = repo[:i], repo[i+1:]
+	}
+
+	return repo, tag, digest
+}
+
+// Lock records sha256, the digest s resolved to, in the lock file. For GitHub
+// releases the version is recorded too, so a source without a version stays
+// on the release it was locked at, and for OCI references the tag is recorded
+// as the version.
+func (l *LockFile) Lock(s PluginSource, version, sha256 string) error {
+	key, err := PluginSourceKey(s)
+	if err != nil {
+		return err
+	}
+
+	if !isSHA256(sha256) {
+		return fmt.Errorf("plugin %s: invalid sha256 %q", key, sha256)
+	}
+
+	switch s := s.(type) {
+	case PluginSourceGitHubRelease:
+	case PluginSourceOCI:
+		_, version, _ = splitOCIReference(s.Reference)
+	default:
+		version = ""
+	}
+
+	if l.Plugins == nil {
+		l.Plugins = map[string]LockedPlugin{}
+	}
+
+	l.Plugins[key] = LockedPlugin{Version: version, SHA256: sha256}
+
+	return nil
+}
+
+// Pin returns s with its version and digest set from the lock file. It's an
+// error if s isn't locked, or if it's already pinned to a different version or
+// digest than the one recorded.
+func (l LockFile) Pin(s PluginSource) (PluginSource, error) {
+	key, err := PluginSourceKey(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := l.Plugins[key]
+	if !ok {
+		return nil, fmt.Errorf("plugin %s is not locked", key)
+	}
+
+	switch s := s.(type) {
+	case PluginSourceGitHubRelease:
+		if s.Version != "" && s.Version != p.Version {
+			return nil, fmt.Errorf("plugin %s: version %q doesn't match locked version %q", key, s.Version, p.Version)
+		}
+
+		if s.SHA256 != "" && !strings.EqualFold(s.SHA256, p.SHA256) {
+			return nil, fmt.Errorf("plugin %s: sha256 %q doesn't match locked sha256 %q", key, s.SHA256, p.SHA256)
+		}
+
+		s.Version = p.Version
+		s.SHA256 = p.SHA256
+		return s, nil
+	case PluginSourceHTTP:
+		if s.SHA256 != "" && !strings.EqualFold(s.SHA256, p.SHA256) {
+			return nil, fmt.Errorf("plugin %s: sha256 %q doesn't match locked sha256 %q", key, s.SHA256, p.SHA256)
+		}
+
+		s.SHA256 = p.SHA256
+		return s, nil
+	case PluginSourceOCI:
+		_, tag, refDigest := splitOCIReference(s.Reference)
+		if tag != "" && tag != p.Version {
+			return nil, fmt.Errorf("plugin %s: tag %q doesn't match locked tag %q", key, tag, p.Version)
+		}
+
+		digest := "sha256:" + p.SHA256
+		for _, d := range []string{refDigest, s.Digest} {
+			if d != "" && !strings.EqualFold(d, digest) {
+				return nil, fmt.Errorf("plugin %s: digest %q doesn't match locked digest %q", key, d, digest)
+			}
+		}
+
+		s.Digest = digest
+		return s, nil
+	default:
+		return nil, fmt.Errorf("plugin source %T can't be locked", s)
+	}
+}
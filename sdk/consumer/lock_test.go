@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitOCIReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+
+	tests := []struct {
+		ref               string
+		repo, tag, digest string
+	}{
+		{ref: "registry.example.com/plugins/aws", repo: "registry.example.com/plugins/aws"},
+		{ref: "registry.example.com/plugins/aws:v1.2.0", repo: "registry.example.com/plugins/aws", tag: "v1.2.0"},
+		{ref: "registry.example.com/plugins/aws@" + digest, repo: "registry.example.com/plugins/aws", digest: digest},
+		{ref: "registry.example.com:5000/plugins/aws", repo: "registry.example.com:5000/plugins/aws"},
+		{ref: "registry.example.com:5000/plugins/aws:v1@" + digest, repo: "registry.example.com:5000/plugins/aws", tag: "v1", digest: digest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			repo, tag, d := splitOCIReference(tt.ref)
+			if repo != tt.repo || tag != tt.tag || d != tt.digest {
+				t.Errorf("got (%q, %q, %q), want (%q, %q, %q)", repo, tag, d, tt.repo, tt.tag, tt.digest)
+			}
+		})
+	}
+}
+
+func TestLockFile(t *testing.T) {
+	sum := strings.Repeat("ab", 32)
+	other := strings.Repeat("cd", 32)
+
+	gh := PluginSourceGitHubRelease{RepoOwner: "alchematik", RepoName: "athanor-provider-gcp", Name: "provider"}
+	oci := PluginSourceOCI{Reference: "registry.example.com:5000/plugins/aws:v1.2.0"}
+	http := PluginSourceHTTP{URL: "https://plugins.example.com/aws"}
+
+	var l LockFile
+	for _, s := range []PluginSource{gh, oci, http} {
+		if err := l.Lock(s, "v0.1.0", sum); err != nil {
+			t.Fatalf("error locking %v: %v", s, err)
+		}
+	}
+
+	if err := l.Lock(PluginSourceFilePath{Path: "provider"}, "", sum); err == nil {
+		t.Errorf("got no error locking a file path source")
+	}
+
+	if err := l.Lock(gh, "v0.1.0", "abc"); err == nil {
+		t.Errorf("got no error locking an invalid sha256")
+	}
+
+	path := filepath.Join(t.TempDir(), "athanor.lock")
+	if err := WriteLockFile(path, l); err != nil {
+		t.Fatalf("error writing lock file: %v", err)
+	}
+
+	read, err := ReadLockFile(path)
+	if err != nil {
+		t.Fatalf("error reading lock file: %v", err)
+	}
+
+	want := map[string]LockedPlugin{
+		"github.com/alchematik/athanor-provider-gcp/provider": {Version: "v0.1.0", SHA256: sum},
+		"registry.example.com:5000/plugins/aws":               {Version: "v1.2.0", SHA256: sum},
+		"https://plugins.example.com/aws":                     {SHA256: sum},
+	}
+	if !reflect.DeepEqual(read.Plugins, want) {
+		t.Errorf("got plugins %v, want %v", read.Plugins, want)
+	}
+
+	tests := []struct {
+		name   string
+		source PluginSource
+		want   PluginSource
+		err    string
+	}{
+		{
+			name:   "github",
+			source: gh,
+			want:   PluginSourceGitHubRelease{RepoOwner: "alchematik", RepoName: "athanor-provider-gcp", Name: "provider", Version: "v0.1.0", SHA256: sum},
+		},
+		{
+			name:   "github version mismatch",
+			source: PluginSourceGitHubRelease{RepoOwner: "alchematik", RepoName: "athanor-provider-gcp", Name: "provider", Version: "v0.2.0"},
+			err:    `plugin github.com/alchematik/athanor-provider-gcp/provider: version "v0.2.0" doesn't match locked version "v0.1.0"`,
+		},
+		{
+			name:   "http",
+			source: http,
+			want:   PluginSourceHTTP{URL: "https://plugins.example.com/aws", SHA256: sum},
+		},
+		{
+			name:   "http sha256 mismatch",
+			source: PluginSourceHTTP{URL: "https://plugins.example.com/aws", SHA256: other},
+			err:    `plugin https://plugins.example.com/aws: sha256 "` + other + `" doesn't match locked sha256 "` + sum + `"`,
+		},
+		{
+			name:   "oci",
+			source: oci,
+			want:   PluginSourceOCI{Reference: "registry.example.com:5000/plugins/aws:v1.2.0", Digest: "sha256:" + sum},
+		},
+		{
+			name:   "oci without tag",
+			source: PluginSourceOCI{Reference: "registry.example.com:5000/plugins/aws"},
+			want:   PluginSourceOCI{Reference: "registry.example.com:5000/plugins/aws", Digest: "sha256:" + sum},
+		},
+		{
+			name:   "oci tag bump",
+			source: PluginSourceOCI{Reference: "registry.example.com:5000/plugins/aws:v1.3.0"},
+			err:    `plugin registry.example.com:5000/plugins/aws: tag "v1.3.0" doesn't match locked tag "v1.2.0"`,
+		},
+		{
+			name:   "oci reference digest mismatch",
+			source: PluginSourceOCI{Reference: "registry.example.com:5000/plugins/aws@sha256:" + other},
+			err:    `plugin registry.example.com:5000/plugins/aws: digest "sha256:` + other + `" doesn't match locked digest "sha256:` + sum + `"`,
+		},
+		{
+			name:   "not locked",
+			source: PluginSourceHTTP{URL: "https://plugins.example.com/gcp"},
+			err:    "plugin https://plugins.example.com/gcp is not locked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := read.Pin(tt.source)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("got error %v, want %q", err, tt.err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"fmt"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+)
+
+// ResourceOptions control how the engine manages a resource over its
+// lifecycle. They are set with ResourceOption values passed to WithResource.
+type ResourceOptions struct {
+	// PreventDestroy makes the engine refuse to delete the resource.
+	PreventDestroy bool
+	// IgnoreChanges lists config fields, as dot separated paths such as
+	// "tags" or "network.subnet", whose changes don't cause an update.
+	IgnoreChanges []string
+	// CreateBeforeDestroy makes the engine create a replacement before
+	// deleting the existing resource.
+	CreateBeforeDestroy bool
+	// DependsOn lists the aliases of resources and builds that must be
+	// applied before this resource even though it doesn't reference them.
+	DependsOn []string
+}
+
+type ResourceOption func(*ResourceOptions)
+
+func PreventDestroy() ResourceOption {
+	return func(o *ResourceOptions) {
+		o.PreventDestroy = true
+	}
+}
+
+func IgnoreChanges(paths ...string) ResourceOption {
+	return func(o *ResourceOptions) {
+		o.IgnoreChanges = append(o.IgnoreChanges, paths...)
+	}
+}
+
+func CreateBeforeDestroy() ResourceOption {
+	return func(o *ResourceOptions) {
+		o.CreateBeforeDestroy = true
+	}
+}
+
+func DependsOn(aliases ...string) ResourceOption {
+	return func(o *ResourceOptions) {
+		o.DependsOn = append(o.DependsOn, aliases...)
+	}
+}
+
+func resourceOptionsToProto(opts []ResourceOption) (*blueprintpb.ResourceOptions, error) {
+	if len(opts) == 0 {
+		return nil, nil
+	}
+
+	var o ResourceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for _, path := range o.IgnoreChanges {
+		if path == "" {
+			return nil, fmt.Errorf("empty ignore changes path")
+		}
+	}
+
+	for _, alias := range o.DependsOn {
+		if alias == "" {
+			return nil, fmt.Errorf("empty depends on alias")
+		}
+	}
+
+	return &blueprintpb.ResourceOptions{
+		PreventDestroy:      o.PreventDestroy,
+		IgnoreChanges:       o.IgnoreChanges,
+		CreateBeforeDestroy: o.CreateBeforeDestroy,
+		DependsOn:           o.DependsOn,
+	}, nil
+}
@@ -0,0 +1,381 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BuildWith is the entrypoint of a blueprint program whose config is a set of
+// named parameters. See Params for how the config is decoded into T.
+func BuildWith[T any](fn func(T) (Blueprint, error)) {
+	Build(Params(fn))
+}
+
+// Params adapts fn to a BlueprintFunc that decodes its config into the struct
+// type T. The config must be a single map of parameter names to values. Each
+// exported field of T is a parameter, named by its athanor tag or else by the
+// field name:
+//
+//	type Params struct {
+//		Env     string   `athanor:"env,required,oneof=dev|staging|prod"`
+//		Regions []string `athanor:"regions"`
+//		Replicas int     `athanor:"replicas,default=3"`
+//	}
+//
+// The tag options are required, default=<value> for string, bool and numeric
+// fields, and oneof=<a|b|...> to restrict a field to a set of values. A tag of
+// "-" skips the field. A missing struct parameter is decoded as an empty map,
+// so its required fields are still checked. Unknown, missing and mistyped
+// parameters are reported together.
+func Params[T any](fn func(T) (Blueprint, error)) BlueprintFunc {
+	return func(args ...any) (Blueprint, error) {
+		var params T
+		if err := decodeParams(args, &params); err != nil {
+			return Blueprint{}, err
+		}
+
+		return fn(params)
+	}
+}
+
+func decodeParams(args []any, params any) error {
+	v := reflect.ValueOf(params).Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("params must be a struct, got %s", v.Type())
+	}
+
+	var values map[string]any
+	switch len(args) {
+	case 0:
+	case 1:
+		if args[0] != nil {
+			m, ok := args[0].(map[string]any)
+			if !ok {
+				return fmt.Errorf("config must be a map of parameters, got %s", exprTypeName(args[0]))
+			}
+
+			values = m
+		}
+	default:
+		return fmt.Errorf("config must be a single map of parameters, got %d values", len(args))
+	}
+
+	return errors.Join(decodeStruct("", values, v)...)
+}
+
+type paramTag struct {
+	name       string
+	required   bool
+	defaultVal *string
+	oneOf      []string
+}
+
+func parseParamTag(f reflect.StructField) (paramTag, bool) {
+	tag, ok := f.Tag.Lookup("athanor")
+	if tag == "-" {
+		return paramTag{}, false
+	}
+
+	pt := paramTag{name: f.Name}
+	if !ok {
+		return pt, true
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		pt.name = parts[0]
+	}
+
+	for _, opt := range parts[1:] {
+		switch {
+		case opt == "required":
+			pt.required = true
+		case strings.HasPrefix(opt, "default="):
+			d := strings.TrimPrefix(opt, "default=")
+			pt.defaultVal = &d
+		case strings.HasPrefix(opt, "oneof="):
+			pt.oneOf = strings.Split(strings.TrimPrefix(opt, "oneof="), "|")
+		}
+	}
+
+	return pt, true
+}
+
+func decodeStruct(path string, values map[string]any, v reflect.Value) []error {
+	var errs []error
+	known := map[string]bool{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, ok := parseParamTag(f)
+		if !ok {
+			continue
+		}
+
+		known[tag.name] = true
+		name := joinParamPath(path, tag.name)
+
+		val, ok := values[tag.name]
+		if !ok || val == nil {
+			switch {
+			case tag.defaultVal != nil:
+				err := setDefault(v.Field(i), *tag.defaultVal)
+				if err == nil {
+					err = checkOneOf(v.Field(i), tag.oneOf)
+				}
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("parameter %q: invalid default: %v", name, err))
+				}
+			case tag.required:
+				errs = append(errs, fmt.Errorf("missing required parameter %q", name))
+			case v.Field(i).Kind() == reflect.Struct:
+				// A missing struct is decoded as an empty one, so that its
+				// required fields are reported and its defaults applied.
+				errs = append(errs, decodeStruct(name, nil, v.Field(i))...)
+			}
+
+			continue
+		}
+
+		fieldErrs := decodeValue(name, val, v.Field(i))
+		if len(fieldErrs) == 0 {
+			if err := checkOneOf(v.Field(i), tag.oneOf); err != nil {
+				fieldErrs = append(fieldErrs, fmt.Errorf("parameter %q: %v", name, err))
+			}
+		}
+
+		errs = append(errs, fieldErrs...)
+	}
+
+	var unknown []string
+	for k := range values {
+		if !known[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+
+	for _, k := range unknown {
+		errs = append(errs, fmt.Errorf("unknown parameter %q", joinParamPath(path, k)))
+	}
+
+	return errs
+}
+
+// decodeValue decodes val into v. A nil value, such as a null list element,
+// leaves v's zero value in place.
+func decodeValue(name string, val any, v reflect.Value) []error {
+	if val == nil {
+		return nil
+	}
+
+	mismatch := func(want string) []error {
+		return []error{fmt.Errorf("parameter %q: expected %s, got %s", name, want, exprTypeName(val))}
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.NumMethod() > 0 {
+			return []error{fmt.Errorf("parameter %q: unsupported type %s", name, v.Type())}
+		}
+
+		v.Set(reflect.ValueOf(val))
+	case reflect.String:
+		s, ok := val.(string)
+		if !ok {
+			return mismatch("string")
+		}
+
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := val.(bool)
+		if !ok {
+			return mismatch("bool")
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := val.(int)
+		if !ok {
+			return mismatch("int")
+		}
+
+		if v.OverflowInt(int64(n)) {
+			return []error{fmt.Errorf("parameter %q: %d overflows %s", name, n, v.Type())}
+		}
+
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := val.(int)
+		if !ok {
+			return mismatch("int")
+		}
+
+		if n < 0 || v.OverflowUint(uint64(n)) {
+			return []error{fmt.Errorf("parameter %q: %d overflows %s", name, n, v.Type())}
+		}
+
+		v.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		switch n := val.(type) {
+		case float64:
+			v.SetFloat(n)
+		case int:
+			v.SetFloat(float64(n))
+		default:
+			return mismatch("float")
+		}
+	case reflect.Slice:
+		l, ok := val.([]any)
+		if !ok {
+			return mismatch("list")
+		}
+
+		s := reflect.MakeSlice(v.Type(), len(l), len(l))
+		var errs []error
+		for i, el := range l {
+			errs = append(errs, decodeValue(fmt.Sprintf("%s[%d]", name, i), el, s.Index(i))...)
+		}
+
+		v.Set(s)
+		return errs
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return []error{fmt.Errorf("parameter %q: unsupported type %s", name, v.Type())}
+		}
+
+		m, ok := val.(map[string]any)
+		if !ok {
+			return mismatch("map")
+		}
+
+		out := reflect.MakeMapWithSize(v.Type(), len(m))
+		var errs []error
+		for _, k := range sortedNames(m) {
+			el := reflect.New(v.Type().Elem()).Elem()
+			errs = append(errs, decodeValue(joinParamPath(name, k), m[k], el)...)
+			out.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), el)
+		}
+
+		v.Set(out)
+		return errs
+	case reflect.Struct:
+		m, ok := val.(map[string]any)
+		if !ok {
+			return mismatch("map")
+		}
+
+		return decodeStruct(name, m, v)
+	case reflect.Pointer:
+		p := reflect.New(v.Type().Elem())
+		errs := decodeValue(name, val, p.Elem())
+		v.Set(p)
+		return errs
+	default:
+		return []error{fmt.Errorf("parameter %q: unsupported type %s", name, v.Type())}
+	}
+
+	return nil
+}
+
+// checkOneOf checks that v is one of values, if any are given.
+func checkOneOf(v reflect.Value, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	s := fmt.Sprint(v.Interface())
+	if !contains(values, s) {
+		return fmt.Errorf("%q must be one of %s", s, strings.Join(values, ", "))
+	}
+
+	return nil
+}
+
+func setDefault(v reflect.Value, d string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(d)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(d)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(d, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(d, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(d, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("defaults aren't supported for %s", v.Type())
+	}
+
+	return nil
+}
+
+func joinParamPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exprTypeName describes the type of a decoded config value.
+func exprTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int:
+		return "int"
+	case float64:
+		return "float"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "map"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
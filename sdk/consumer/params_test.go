@@ -0,0 +1,208 @@
+package sdk
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testParams struct {
+	Env      string            `athanor:"env,required,oneof=dev|prod"`
+	Replicas int8              `athanor:"replicas,default=3"`
+	Ports    []uint16          `athanor:"ports"`
+	Tags     map[string]any    `athanor:"tags"`
+	Values   []any             `athanor:"values"`
+	Labels   map[string]string `athanor:"labels"`
+	Limit    *int              `athanor:"limit"`
+	Network  testNetwork       `athanor:"network"`
+	Skipped  string            `athanor:"-"`
+}
+
+type testNetwork struct {
+	CIDR string `athanor:"cidr,required"`
+	Mask int    `athanor:"mask,default=16"`
+}
+
+func TestParams(t *testing.T) {
+	limit := 5
+
+	tests := []struct {
+		name   string
+		config any
+		want   testParams
+		errs   []string
+	}{
+		{
+			name: "valid",
+			config: map[string]any{
+				"env":     "prod",
+				"ports":   []any{80, 443},
+				"labels":  map[string]any{"team": "infra"},
+				"limit":   5,
+				"network": map[string]any{"cidr": "10.0.0.0/16"},
+			},
+			want: testParams{
+				Env:      "prod",
+				Replicas: 3,
+				Ports:    []uint16{80, 443},
+				Labels:   map[string]string{"team": "infra"},
+				Limit:    &limit,
+				Network:  testNetwork{CIDR: "10.0.0.0/16", Mask: 16},
+			},
+		},
+		{
+			name: "nil elements",
+			config: map[string]any{
+				"env":     "dev",
+				"tags":    map[string]any{"a": nil},
+				"values":  []any{nil, "x"},
+				"labels":  map[string]any{"a": nil},
+				"ports":   []any{nil},
+				"limit":   nil,
+				"network": map[string]any{"cidr": "10.0.0.0/16"},
+			},
+			want: testParams{
+				Env:      "dev",
+				Replicas: 3,
+				Tags:     map[string]any{"a": nil},
+				Values:   []any{nil, "x"},
+				Labels:   map[string]string{"a": ""},
+				Ports:    []uint16{0},
+				Network:  testNetwork{CIDR: "10.0.0.0/16", Mask: 16},
+			},
+		},
+		{
+			name: "overflow",
+			config: map[string]any{
+				"env":      "dev",
+				"replicas": 300,
+				"ports":    []any{-1, 70000},
+				"network":  map[string]any{"cidr": "10.0.0.0/16"},
+			},
+			errs: []string{
+				`parameter "replicas": 300 overflows int8`,
+				`parameter "ports[0]": -1 overflows uint16`,
+				`parameter "ports[1]": 70000 overflows uint16`,
+			},
+		},
+		{
+			name: "unknown",
+			config: map[string]any{
+				"env":     "dev",
+				"region":  "us-east1",
+				"network": map[string]any{"cidr": "10.0.0.0/16", "vpc": "main"},
+			},
+			errs: []string{
+				`unknown parameter "network.vpc"`,
+				`unknown parameter "region"`,
+			},
+		},
+		{
+			name:   "missing",
+			config: map[string]any{"network": map[string]any{}},
+			errs: []string{
+				`missing required parameter "env"`,
+				`missing required parameter "network.cidr"`,
+			},
+		},
+		{
+			name:   "missing struct",
+			config: map[string]any{"env": "dev"},
+			errs:   []string{`missing required parameter "network.cidr"`},
+		},
+		{
+			name: "oneof",
+			config: map[string]any{
+				"env":     "staging",
+				"network": map[string]any{"cidr": "10.0.0.0/16"},
+			},
+			errs: []string{`parameter "env": "staging" must be one of dev, prod`},
+		},
+		{
+			name: "mistyped",
+			config: map[string]any{
+				"env":     true,
+				"labels":  []any{"a"},
+				"network": "10.0.0.0/16",
+			},
+			errs: []string{
+				`parameter "env": expected string, got bool`,
+				`parameter "labels": expected map, got list`,
+				`parameter "network": expected map, got string`,
+			},
+		},
+		{
+			name:   "not a map",
+			config: "prod",
+			errs:   []string{"config must be a map of parameters, got string"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got testParams
+			bf := Params(func(p testParams) (Blueprint, error) {
+				got = p
+				return Blueprint{}, nil
+			})
+
+			_, err := bf(tt.config)
+			if len(tt.errs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("got %+v, want %+v", got, tt.want)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("got no error, want %q", tt.errs)
+			}
+
+			if got, want := err.Error(), strings.Join(tt.errs, "\n"); got != want {
+				t.Errorf("got error:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestParamsDefaults(t *testing.T) {
+	type tier struct {
+		Name string `athanor:"name,default=gold,oneof=gold|silver"`
+	}
+
+	type valid struct {
+		Tier tier `athanor:"tier"`
+	}
+
+	var got valid
+	if _, err := Params(func(p valid) (Blueprint, error) {
+		got = p
+		return Blueprint{}, nil
+	})(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Tier.Name != "gold" {
+		t.Errorf("got tier %q, want the default of the missing struct", got.Tier.Name)
+	}
+
+	type invalid struct {
+		Tier     string `athanor:"tier,default=gold,oneof=silver|bronze"`
+		Replicas int    `athanor:"replicas,default=three"`
+	}
+
+	_, err := Params(func(invalid) (Blueprint, error) { return Blueprint{}, nil })(nil)
+
+	want := strings.Join([]string{
+		`parameter "tier": invalid default: "gold" must be one of silver, bronze`,
+		`parameter "replicas": invalid default: strconv.ParseInt: parsing "three": invalid syntax`,
+	}, "\n")
+	if err == nil || err.Error() != want {
+		t.Errorf("got error:\n%v\nwant:\n%s", err, want)
+	}
+}
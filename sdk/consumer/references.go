@@ -0,0 +1,318 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// reference is a use of an alias within a statement.
+type reference struct {
+	alias string
+	// mustResolve is true if the alias is the root of a Get chain or named by
+	// depends_on, which must refer to a resource or build declared in the
+	// blueprint. Nested resource identifiers may also identify resources
+	// managed elsewhere.
+	mustResolve bool
+}
+
+// declaredAlias returns the alias declared by a compiled statement.
+func declaredAlias(stmt *blueprintpb.Stmt) string {
+	switch s := stmt.GetType().(type) {
+	case *blueprintpb.Stmt_Resource:
+		return s.Resource.GetResource().GetIdentifier().GetResourceIdentifier().GetAlias()
+	case *blueprintpb.Stmt_Build:
+		return s.Build.GetBuild().GetAlias()
+	default:
+		return ""
+	}
+}
+
+// stmtReferences returns the aliases referenced by a compiled statement, in
+// the order they appear.
+func stmtReferences(stmt *blueprintpb.Stmt) []reference {
+	var refs []reference
+	switch s := stmt.GetType().(type) {
+	case *blueprintpb.Stmt_Resource:
+		res := s.Resource
+		refs = exprReferences(res.GetExists(), refs)
+		refs = exprReferences(res.GetProvider().GetConfig(), refs)
+		// The top level identifier declares the statement's alias, so only its
+		// value can reference other resources.
+		if id := res.GetResource().GetIdentifier().GetResourceIdentifier(); id != nil {
+			refs = exprReferences(id.GetValue(), refs)
+		} else {
+			refs = exprReferences(res.GetResource().GetIdentifier(), refs)
+		}
+		refs = exprReferences(res.GetResource().GetConfig(), refs)
+		for _, alias := range res.GetOptions().GetDependsOn() {
+			refs = append(refs, reference{alias: alias, mustResolve: true})
+		}
+	case *blueprintpb.Stmt_Build:
+		build := s.Build.GetBuild()
+		for _, c := range build.GetConfig() {
+			refs = exprReferences(c, refs)
+		}
+		refs = exprReferences(build.GetRuntimeConfig(), refs)
+	case *blueprintpb.Stmt_Output:
+		refs = exprReferences(s.Output.GetValue(), refs)
+	}
+
+	return refs
+}
+
+func exprReferences(e *blueprintpb.Expr, refs []reference) []reference {
+	switch t := e.GetType().(type) {
+	case *blueprintpb.Expr_Get:
+		g := t.Get
+		for {
+			next := g.GetObject().GetGet()
+			if next == nil {
+				break
+			}
+
+			g = next
+		}
+
+		switch g.GetObject().GetType().(type) {
+		case nil, *blueprintpb.Expr_Nil:
+			return append(refs, reference{alias: g.GetName(), mustResolve: true})
+		default:
+			return exprReferences(g.GetObject(), refs)
+		}
+	case *blueprintpb.Expr_ResourceIdentifier:
+		refs = append(refs, reference{alias: t.ResourceIdentifier.GetAlias()})
+		return exprReferences(t.ResourceIdentifier.GetValue(), refs)
+	case *blueprintpb.Expr_Provider:
+		return exprReferences(t.Provider.GetConfig(), refs)
+	case *blueprintpb.Expr_Resource:
+		refs = exprReferences(t.Resource.GetIdentifier(), refs)
+		return exprReferences(t.Resource.GetConfig(), refs)
+	case *blueprintpb.Expr_List:
+		for _, el := range t.List.GetElements() {
+			refs = exprReferences(el, refs)
+		}
+		return refs
+	case *blueprintpb.Expr_Map:
+		keys := make([]string, 0, len(t.Map.GetEntries()))
+		for k := range t.Map.GetEntries() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			refs = exprReferences(t.Map.GetEntries()[k], refs)
+		}
+		return refs
+	case *blueprintpb.Expr_Build:
+		for _, c := range t.Build.GetConfig() {
+			refs = exprReferences(c, refs)
+		}
+		return exprReferences(t.Build.GetRuntimeConfig(), refs)
+	case *blueprintpb.Expr_Concat:
+		for _, part := range t.Concat.GetParts() {
+			refs = exprReferences(part, refs)
+		}
+		return refs
+	case *blueprintpb.Expr_Function:
+		for _, arg := range t.Function.GetArgs() {
+			refs = exprReferences(arg, refs)
+		}
+		return refs
+	default:
+		return refs
+	}
+}
+
+// dependencies returns, for each statement, the indexes of the statements it
+// references. aliases maps each declared alias to its statement.
+func dependencies(bp *blueprintpb.Blueprint, aliases map[string]int) [][]int {
+	deps := make([][]int, len(bp.GetStmts()))
+	for i, stmt := range bp.GetStmts() {
+		seen := map[int]bool{}
+		for _, ref := range stmtReferences(stmt) {
+			j, ok := aliases[ref.alias]
+			if !ok || seen[j] {
+				continue
+			}
+
+			seen[j] = true
+			deps[i] = append(deps[i], j)
+		}
+	}
+
+	return deps
+}
+
+// checkReferences reports statements that declare an alias or output that is
+// already in use, providers that are configured differently for the same
+// source and alias, Get chains rooted at aliases that aren't declared, and
+// cycles between statements.
+func checkReferences(bp *blueprintpb.Blueprint) error {
+	stmts := bp.GetStmts()
+	stmtErrs := make([][]error, len(stmts))
+
+	aliases := map[string]int{}
+	for i, stmt := range stmts {
+		alias := declaredAlias(stmt)
+		if alias == "" {
+			continue
+		}
+
+		if first, ok := aliases[alias]; ok {
+			if origin := stmts[first].GetOrigin(); origin != "" {
+				stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("duplicate alias %q, already declared by statement %d from %s", alias, first, origin))
+			} else {
+				stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("duplicate alias %q, already declared by statement %d", alias, first))
+			}
+			continue
+		}
+
+		aliases[alias] = i
+	}
+
+	outputs := map[string]int{}
+	for i, stmt := range stmts {
+		out := stmt.GetOutput()
+		if out == nil {
+			continue
+		}
+
+		if out.GetName() == "" {
+			stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("output without a name"))
+			continue
+		}
+
+		if first, ok := outputs[out.GetName()]; ok {
+			stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("duplicate output %q, already declared by statement %d", out.GetName(), first))
+			continue
+		}
+
+		outputs[out.GetName()] = i
+	}
+
+	type providerKey struct {
+		source string
+		alias  string
+	}
+
+	providers := map[providerKey]int{}
+	for i, stmt := range stmts {
+		p := stmt.GetResource().GetProvider()
+		if p == nil {
+			continue
+		}
+
+		source, err := proto.MarshalOptions{Deterministic: true}.Marshal(p.GetSource())
+		if err != nil {
+			stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("error encoding provider source: %v", err))
+			continue
+		}
+
+		key := providerKey{source: string(source), alias: p.GetAlias()}
+		first, ok := providers[key]
+		if !ok {
+			providers[key] = i
+			continue
+		}
+
+		if !proto.Equal(stmts[first].GetResource().GetProvider().GetConfig(), p.GetConfig()) {
+			stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("conflicting config for provider %q with alias %q, already configured by statement %d", pluginSourceName(p.GetSource()), p.GetAlias(), first))
+		}
+	}
+
+	for i, stmt := range stmts {
+		for _, ref := range stmtReferences(stmt) {
+			if _, ok := aliases[ref.alias]; ref.mustResolve && !ok {
+				stmtErrs[i] = append(stmtErrs[i], fmt.Errorf("reference to undeclared alias %q", ref.alias))
+			}
+		}
+	}
+
+	for _, cycle := range findCycles(dependencies(bp, aliases)) {
+		names := make([]string, len(cycle)+1)
+		for n, i := range cycle {
+			names[n] = declaredAlias(stmts[i])
+		}
+		names[len(cycle)] = names[0]
+
+		stmtErrs[cycle[0]] = append(stmtErrs[cycle[0]], fmt.Errorf("dependency cycle: %s", strings.Join(names, " -> ")))
+	}
+
+	var errs []error
+	for i, e := range stmtErrs {
+		if len(e) == 0 {
+			continue
+		}
+
+		errs = append(errs, StmtError{Index: i, Alias: declaredAlias(stmts[i]), Origin: stmts[i].GetOrigin(), Err: errors.Join(e...)})
+	}
+
+	return errors.Join(errs...)
+}
+
+// pluginSourceName returns the location of a plugin source for use in errors.
+func pluginSourceName(s *blueprintpb.PluginSource) string {
+	switch t := s.GetType().(type) {
+	case *blueprintpb.PluginSource_FilePath:
+		return t.FilePath.GetPath()
+	case *blueprintpb.PluginSource_GitHubRelease:
+		return "github.com/" + t.GitHubRelease.GetRepoOwner() + "/" + t.GitHubRelease.GetRepoName() + "/" + t.GitHubRelease.GetName()
+	case *blueprintpb.PluginSource_Http:
+		return t.Http.GetUrl()
+	case *blueprintpb.PluginSource_Oci:
+		return t.Oci.GetReference()
+	default:
+		return fmt.Sprintf("%T", t)
+	}
+}
+
+// findCycles returns one cycle through each strongly connected group of
+// statements that depend on each other, starting at the statement with the
+// lowest index.
+func findCycles(deps [][]int) [][]int {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(deps))
+	var stack []int
+	var cycles [][]int
+
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = visiting
+		stack = append(stack, i)
+
+		for _, j := range deps[i] {
+			switch state[j] {
+			case unvisited:
+				visit(j)
+			case visiting:
+				for n := len(stack) - 1; n >= 0; n-- {
+					if stack[n] == j {
+						cycles = append(cycles, append([]int{}, stack[n:]...))
+						break
+					}
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[i] = visited
+	}
+
+	for i := range deps {
+		if state[i] == unvisited {
+			visit(i)
+		}
+	}
+
+	return cycles
+}
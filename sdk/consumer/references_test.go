@@ -0,0 +1,214 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestCheckReferences(t *testing.T) {
+	tests := []struct {
+		name string
+		bf   BlueprintFunc
+		want string
+	}{
+		{
+			name: "valid",
+			bf: testBlueprint(
+				testResource("a", nil),
+				testResource("b", GetResource("a").Get("attrs")),
+				testResource("c", nil, DependsOn("a", "b")),
+			),
+		},
+		{
+			name: "nested identifier of unmanaged resource",
+			bf: testBlueprint(
+				testResource("a", ResourceIdentifier{Alias: "external", ResourceType: "bucket", Value: "name"}),
+			),
+		},
+		{
+			name: "duplicate alias",
+			bf: testBlueprint(
+				testResource("a", nil),
+				testResource("a", nil),
+			),
+			want: `statement 1 (a): duplicate alias "a", already declared by statement 0`,
+		},
+		{
+			name: "duplicate alias from foreach",
+			bf: func(...any) (Blueprint, error) {
+				return ForEach(Blueprint{}, "buckets", []string{"a", "a"}, func(_ int, alias string) Blueprint {
+					return testResource(alias, nil)(Blueprint{})
+				}), nil
+			},
+			want: `statement 1 (a, from foreach(buckets)[1]): duplicate alias "a", already declared by statement 0 from foreach(buckets)[0]`,
+		},
+		{
+			name: "dangling get",
+			bf: testBlueprint(
+				testResource("a", GetResource("missing").Get("attrs")),
+			),
+			want: `statement 0 (a): reference to undeclared alias "missing"`,
+		},
+		{
+			name: "dangling depends_on",
+			bf: testBlueprint(
+				testResource("a", nil, DependsOn("missing")),
+			),
+			want: `statement 0 (a): reference to undeclared alias "missing"`,
+		},
+		{
+			name: "dangling output",
+			bf: testBlueprint(
+				func(b Blueprint) Blueprint { return b.WithOutput("name", GetResource("missing")) },
+			),
+			want: `statement 0: reference to undeclared alias "missing"`,
+		},
+		{
+			name: "duplicate output",
+			bf: testBlueprint(
+				testResource("a", nil),
+				func(b Blueprint) Blueprint { return b.WithOutput("name", GetResource("a")) },
+				func(b Blueprint) Blueprint { return b.WithOutput("name", GetResource("a")) },
+			),
+			want: `statement 2: duplicate output "name", already declared by statement 1`,
+		},
+		{
+			name: "same provider config",
+			bf: testBlueprint(
+				testProviderResource("a", Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod", Config: map[string]any{"region": "us"}}),
+				testProviderResource("b", Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod", Config: map[string]any{"region": "us"}}),
+				testProviderResource("c", Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "eu", Config: map[string]any{"region": "eu"}}),
+				testProviderResource("d", Provider{Source: PluginSourceFilePath{Path: "other"}, Alias: "prod", Config: map[string]any{"region": "eu"}}),
+			),
+		},
+		{
+			name: "conflicting provider config",
+			bf: testBlueprint(
+				testProviderResource("a", Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod", Config: map[string]any{"region": "us"}}),
+				testProviderResource("b", Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod", Config: map[string]any{"region": "eu"}}),
+				testProviderResource("c", Provider{Source: PluginSourceFilePath{Path: "provider"}, Alias: "prod"}),
+			),
+			want: `statement 1 (b): conflicting config for provider "provider" with alias "prod", already configured by statement 0
+statement 2 (c): conflicting config for provider "provider" with alias "prod", already configured by statement 0`,
+		},
+		{
+			name: "self cycle",
+			bf: testBlueprint(
+				testResource("a", GetResource("a").Get("attrs")),
+			),
+			want: `statement 0 (a): dependency cycle: a -> a`,
+		},
+		{
+			name: "mutual cycle",
+			bf: testBlueprint(
+				testResource("a", GetResource("b").Get("attrs")),
+				testResource("b", nil, DependsOn("c")),
+				testResource("c", GetResource("a").Get("attrs")),
+			),
+			want: `statement 0 (a): dependency cycle: a -> b -> c -> a`,
+		},
+		{
+			name: "depends_on cycle",
+			bf: testBlueprint(
+				testResource("a", nil, DependsOn("b")),
+				testResource("b", nil, DependsOn("a")),
+			),
+			want: `statement 0 (a): dependency cycle: a -> b -> a`,
+		},
+		{
+			name: "multiple errors",
+			bf: testBlueprint(
+				testResource("a", GetResource("a")),
+				testResource("a", GetResource("missing")),
+			),
+			want: `statement 0 (a): dependency cycle: a -> a
+statement 1 (a): duplicate alias "a", already declared by statement 0
+statement 1 (a): reference to undeclared alias "missing"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.bf, nil)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("got no error, want %q", tt.want)
+			}
+
+			if err.Error() != tt.want {
+				t.Errorf("got error:\n%v\nwant:\n%v", err, tt.want)
+			}
+		})
+	}
+}
+
+func testProviderResource(alias string, p Provider) func(Blueprint) Blueprint {
+	return func(b Blueprint) Blueprint {
+		return b.WithResource(true, p, Resource{
+			Identifier: ResourceIdentifier{Alias: alias, ResourceType: "bucket", Value: alias},
+		})
+	}
+}
+
+func TestFindCycles(t *testing.T) {
+	tests := []struct {
+		name string
+		deps [][]int
+		want [][]int
+	}{
+		{
+			name: "acyclic",
+			deps: [][]int{{1, 2}, {2}, nil},
+		},
+		{
+			name: "self",
+			deps: [][]int{nil, {1}},
+			want: [][]int{{1}},
+		},
+		{
+			name: "mutual",
+			deps: [][]int{{1}, {2}, {0}},
+			want: [][]int{{0, 1, 2}},
+		},
+		{
+			name: "separate",
+			deps: [][]int{{1}, {0}, {3}, {2}},
+			want: [][]int{{0, 1}, {2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findCycles(tt.deps)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got cycles %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if !equalInts(got[i], tt.want[i]) {
+					t.Errorf("got cycles %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
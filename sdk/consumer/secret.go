@@ -0,0 +1,19 @@
+package sdk
+
+// Secret is a sensitive string that the engine reads from an environment
+// variable or a file when the blueprint is evaluated, so the value itself
+// never appears in the blueprint. Exactly one of Env and File must be set.
+type Secret struct {
+	Env  string
+	File string
+}
+
+// SecretFromEnv returns a secret read from the environment variable name.
+func SecretFromEnv(name string) Expr[string] {
+	return Expr[string]{expr: Secret{Env: name}}
+}
+
+// SecretFromFile returns a secret read from the file at path.
+func SecretFromFile(path string) Expr[string] {
+	return Expr[string]{expr: Secret{File: path}}
+}
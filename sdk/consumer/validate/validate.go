@@ -0,0 +1,469 @@
+// Package validate checks compiled blueprints against provider schemas
+// without running any providers, so that mistakes in resource identifiers,
+// configs and references are caught before reconciling.
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+// LoadSchema reads a provider schema as written by the translator.
+func LoadSchema(path string) (*providerpb.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema providerpb.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("error parsing schema %s: %v", path, err)
+	}
+
+	return &schema, nil
+}
+
+// Validate checks the resource and output statements of bp against schemas.
+// It reports unknown resource types, unknown and missing fields, literals whose
+// type doesn't match the schema, and Get chains that reference fields that
+// don't exist. Problems are returned together as sdk.StmtErrors.
+func Validate(bp *blueprintpb.Blueprint, schemas ...*providerpb.Schema) error {
+	v := &validator{
+		schemas:   schemas,
+		resources: map[string]*providerpb.ResourceSchema{},
+	}
+
+	// Resolve the schema of every aliased resource first so that Get chains can
+	// refer to resources declared later in the blueprint.
+	for _, stmt := range bp.GetStmts() {
+		res := stmt.GetResource()
+		if res == nil {
+			continue
+		}
+
+		id := res.GetResource().GetIdentifier().GetResourceIdentifier()
+		if id == nil {
+			continue
+		}
+
+		if rs := v.resourceSchema(res.GetProvider().GetName(), id.GetType()); rs != nil {
+			v.resources[id.GetAlias()] = rs
+		}
+	}
+
+	var errs []error
+	for i, stmt := range bp.GetStmts() {
+		if out := stmt.GetOutput(); out != nil {
+			if outErrs := v.gets("output "+out.GetName(), out.GetValue()); len(outErrs) > 0 {
+				errs = append(errs, sdk.StmtError{Index: i, Origin: stmt.GetOrigin(), Err: errors.Join(outErrs...)})
+			}
+			continue
+		}
+
+		res := stmt.GetResource()
+		if res == nil {
+			continue
+		}
+
+		stmtErrs := v.resourceStmt(res)
+		if len(stmtErrs) == 0 {
+			continue
+		}
+
+		alias := res.GetResource().GetIdentifier().GetResourceIdentifier().GetAlias()
+		errs = append(errs, sdk.StmtError{Index: i, Alias: alias, Origin: stmt.GetOrigin(), Err: errors.Join(stmtErrs...)})
+	}
+
+	return errors.Join(errs...)
+}
+
+type validator struct {
+	schemas []*providerpb.Schema
+	// resources maps aliases to the schema of the resource they declare.
+	resources map[string]*providerpb.ResourceSchema
+}
+
+// resourceSchema finds the schema for a resource type. If the provider is
+// named, only its schema is searched.
+func (v *validator) resourceSchema(provider, resourceType string) *providerpb.ResourceSchema {
+	for _, s := range v.schemas {
+		if provider != "" && s.GetName() != provider {
+			continue
+		}
+
+		for _, r := range s.GetResources() {
+			if r.GetType() == resourceType {
+				return r
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) resourceStmt(stmt *blueprintpb.ResourceStmt) []error {
+	var errs []error
+	errs = append(errs, v.gets("exists", stmt.GetExists())...)
+	errs = append(errs, v.gets("provider config", stmt.GetProvider().GetConfig())...)
+
+	res := stmt.GetResource()
+	id := res.GetIdentifier().GetResourceIdentifier()
+	if id == nil {
+		return append(errs, fmt.Errorf("identifier: expected resource identifier, got %s", exprKind(res.GetIdentifier())))
+	}
+
+	rs := v.resourceSchema(stmt.GetProvider().GetName(), id.GetType())
+	if rs == nil {
+		return append(errs, fmt.Errorf("unknown resource type %q", id.GetType()))
+	}
+
+	errs = append(errs, v.check("identifier", id.GetValue(), rs.GetIdentifier())...)
+	errs = append(errs, v.check("config", res.GetConfig(), rs.GetConfig())...)
+
+	for _, path := range stmt.GetOptions().GetIgnoreChanges() {
+		if err := checkConfigPath(path, rs.GetConfig()); err != nil {
+			errs = append(errs, fmt.Errorf("ignore_changes: %v", err))
+		}
+	}
+
+	return errs
+}
+
+// checkConfigPath reports whether the dot separated path refers to a field
+// of the config schema f. Map keys aren't known statically, so any key is
+// accepted.
+func checkConfigPath(path string, f *providerpb.FieldSchema) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		parent := strings.Join(append([]string{"config"}, names[:i]...), ".")
+		switch s := unwrap(f).GetType().(type) {
+		case *providerpb.FieldSchema_StructSchema:
+			next, ok := s.StructSchema.GetFields()[name]
+			if !ok {
+				return fmt.Errorf("%q: %s has no field %q", path, parent, name)
+			}
+			f = next
+		case *providerpb.FieldSchema_MapSchema:
+			f = s.MapSchema.GetValue()
+		default:
+			return fmt.Errorf("%q: %s is a %s", path, parent, schemaKind(f))
+		}
+	}
+
+	return nil
+}
+
+// check validates e against the field schema f.
+func (v *validator) check(path string, e *blueprintpb.Expr, f *providerpb.FieldSchema) []error {
+	f = unwrap(f)
+
+	switch t := e.GetType().(type) {
+	case nil, *blueprintpb.Expr_Nil:
+		return nil
+	case *blueprintpb.Expr_Get:
+		target, err := v.resolveGet(t.Get)
+		if err != nil {
+			return []error{fmt.Errorf("%s: %v", path, err)}
+		}
+
+		if target != nil && !sameKind(target, f) {
+			return []error{fmt.Errorf("%s: expected %s, got reference to %s", path, schemaKind(f), schemaKind(target))}
+		}
+
+		return nil
+	case *blueprintpb.Expr_GetRuntimeConfig:
+		return nil
+	case *blueprintpb.Expr_Concat, *blueprintpb.Expr_Function, *blueprintpb.Expr_Secret:
+		// All of these evaluate to strings.
+		errs := v.gets(path, e)
+		if f.GetStringSchema() == nil {
+			errs = append(errs, fmt.Errorf("%s: expected %s, got %s", path, schemaKind(f), exprKind(e)))
+		}
+
+		return errs
+	}
+
+	mismatch := func() []error {
+		return []error{fmt.Errorf("%s: expected %s, got %s", path, schemaKind(f), exprKind(e))}
+	}
+
+	switch s := f.GetType().(type) {
+	case *providerpb.FieldSchema_StringSchema:
+		if !isType[*blueprintpb.Expr_StringLiteral](e) {
+			return mismatch()
+		}
+	case *providerpb.FieldSchema_BoolSchema:
+		if !isType[*blueprintpb.Expr_BoolLiteral](e) {
+			return mismatch()
+		}
+	case *providerpb.FieldSchema_FileSchema:
+		if !isType[*blueprintpb.Expr_File](e) {
+			return mismatch()
+		}
+	case *providerpb.FieldSchema_IdentifierSchema:
+		id := e.GetResourceIdentifier()
+		if id == nil {
+			return mismatch()
+		}
+
+		rs := v.resourceSchema("", id.GetType())
+		if rs == nil {
+			return []error{fmt.Errorf("%s: unknown resource type %q", path, id.GetType())}
+		}
+
+		return v.check(path, id.GetValue(), rs.GetIdentifier())
+	case *providerpb.FieldSchema_ListSchema:
+		if !isType[*blueprintpb.Expr_List](e) {
+			return mismatch()
+		}
+
+		var errs []error
+		for i, el := range e.GetList().GetElements() {
+			errs = append(errs, v.check(fmt.Sprintf("%s[%d]", path, i), el, s.ListSchema.GetElement())...)
+		}
+
+		return errs
+	case *providerpb.FieldSchema_MapSchema:
+		if !isType[*blueprintpb.Expr_Map](e) {
+			return mismatch()
+		}
+
+		var errs []error
+		for _, k := range sortedKeys(e.GetMap().GetEntries()) {
+			errs = append(errs, v.check(fmt.Sprintf("%s[%q]", path, k), e.GetMap().GetEntries()[k], s.MapSchema.GetValue())...)
+		}
+
+		return errs
+	case *providerpb.FieldSchema_StructSchema:
+		if !isType[*blueprintpb.Expr_Map](e) {
+			return mismatch()
+		}
+
+		entries := e.GetMap().GetEntries()
+		fields := s.StructSchema.GetFields()
+
+		var errs []error
+		for _, k := range sortedKeys(entries) {
+			field, ok := fields[k]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: unknown field %q", path, k))
+				continue
+			}
+
+			errs = append(errs, v.check(path+"."+k, entries[k], field)...)
+		}
+
+		for _, k := range sortedKeys(fields) {
+			if _, ok := entries[k]; !ok {
+				errs = append(errs, fmt.Errorf("%s: missing field %q", path, k))
+			}
+		}
+
+		return errs
+	default:
+		return []error{fmt.Errorf("%s: unsupported schema type %T", path, s)}
+	}
+
+	return nil
+}
+
+// gets validates every Get chain within e.
+func (v *validator) gets(path string, e *blueprintpb.Expr) []error {
+	switch t := e.GetType().(type) {
+	case *blueprintpb.Expr_Get:
+		if _, err := v.resolveGet(t.Get); err != nil {
+			return []error{fmt.Errorf("%s: %v", path, err)}
+		}
+	case *blueprintpb.Expr_List:
+		var errs []error
+		for i, el := range t.List.GetElements() {
+			errs = append(errs, v.gets(fmt.Sprintf("%s[%d]", path, i), el)...)
+		}
+		return errs
+	case *blueprintpb.Expr_Map:
+		var errs []error
+		for _, k := range sortedKeys(t.Map.GetEntries()) {
+			errs = append(errs, v.gets(fmt.Sprintf("%s[%q]", path, k), t.Map.GetEntries()[k])...)
+		}
+		return errs
+	case *blueprintpb.Expr_Concat:
+		var errs []error
+		for _, part := range t.Concat.GetParts() {
+			errs = append(errs, v.gets(path, part)...)
+		}
+		return errs
+	case *blueprintpb.Expr_Function:
+		var errs []error
+		for _, arg := range t.Function.GetArgs() {
+			errs = append(errs, v.gets(path, arg)...)
+		}
+		return errs
+	}
+
+	return nil
+}
+
+// resolveGet returns the field schema referenced by a Get chain such as
+// GetResource("bucket").Get("attrs").Get("name"). It returns nil if the chain
+// can't be resolved statically, e.g. because it refers to a build or a map
+// entry.
+func (v *validator) resolveGet(g *blueprintpb.GetExpr) (*providerpb.FieldSchema, error) {
+	var names []string
+	for g != nil {
+		names = append([]string{g.GetName()}, names...)
+		g = g.GetObject().GetGet()
+	}
+
+	rs, ok := v.resources[names[0]]
+	if !ok || len(names) == 1 {
+		return nil, nil
+	}
+
+	var f *providerpb.FieldSchema
+	switch names[1] {
+	case "identifier":
+		f = rs.GetIdentifier()
+	case "config":
+		f = rs.GetConfig()
+	case "attrs":
+		f = rs.GetAttrs()
+	default:
+		return nil, fmt.Errorf("reference to %s: resource %q has no field %q", joinNames(names), rs.GetType(), names[1])
+	}
+
+	for i := 2; i < len(names); i++ {
+		f = unwrap(f)
+
+		switch s := f.GetType().(type) {
+		case *providerpb.FieldSchema_StructSchema:
+			next, ok := s.StructSchema.GetFields()[names[i]]
+			if !ok {
+				return nil, fmt.Errorf("reference to %s: %s has no field %q", joinNames(names), joinNames(names[:i]), names[i])
+			}
+			f = next
+		case *providerpb.FieldSchema_MapSchema:
+			f = s.MapSchema.GetValue()
+		case *providerpb.FieldSchema_IdentifierSchema, *providerpb.FieldSchema_ListSchema:
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("reference to %s: %s is a %s and has no fields", joinNames(names), joinNames(names[:i]), schemaKind(f))
+		}
+	}
+
+	return f, nil
+}
+
+func joinNames(names []string) string {
+	s := fmt.Sprintf("%q", names[0])
+	for _, n := range names[1:] {
+		s += "." + n
+	}
+
+	return s
+}
+
+func isType[T any](e *blueprintpb.Expr) bool {
+	_, ok := e.GetType().(T)
+	return ok
+}
+
+// unwrap returns the schema of the value of an immutable or sensitive field.
+func unwrap(f *providerpb.FieldSchema) *providerpb.FieldSchema {
+	switch t := f.GetType().(type) {
+	case *providerpb.FieldSchema_ImmutableSchema:
+		return unwrap(t.ImmutableSchema.GetValue())
+	case *providerpb.FieldSchema_SensitiveSchema:
+		return unwrap(t.SensitiveSchema.GetValue())
+	default:
+		return f
+	}
+}
+
+func sameKind(a, b *providerpb.FieldSchema) bool {
+	a, b = unwrap(a), unwrap(b)
+	return fmt.Sprintf("%T", a.GetType()) == fmt.Sprintf("%T", b.GetType())
+}
+
+func schemaKind(f *providerpb.FieldSchema) string {
+	switch t := f.GetType().(type) {
+	case *providerpb.FieldSchema_StringSchema:
+		return "string"
+	case *providerpb.FieldSchema_BoolSchema:
+		return "bool"
+	case *providerpb.FieldSchema_MapSchema:
+		return "map"
+	case *providerpb.FieldSchema_StructSchema:
+		return "struct " + t.StructSchema.GetName()
+	case *providerpb.FieldSchema_FileSchema:
+		return "file"
+	case *providerpb.FieldSchema_IdentifierSchema:
+		return "identifier"
+	case *providerpb.FieldSchema_ListSchema:
+		return "list"
+	case *providerpb.FieldSchema_ImmutableSchema:
+		return schemaKind(t.ImmutableSchema.GetValue())
+	case *providerpb.FieldSchema_SensitiveSchema:
+		return schemaKind(t.SensitiveSchema.GetValue())
+	default:
+		return fmt.Sprintf("%T", t)
+	}
+}
+
+func exprKind(e *blueprintpb.Expr) string {
+	switch t := e.GetType().(type) {
+	case *blueprintpb.Expr_StringLiteral:
+		return "string"
+	case *blueprintpb.Expr_IntLiteral:
+		return "int"
+	case *blueprintpb.Expr_FloatLiteral:
+		return "float"
+	case *blueprintpb.Expr_BoolLiteral:
+		return "bool"
+	case *blueprintpb.Expr_List:
+		return "list"
+	case *blueprintpb.Expr_Map:
+		return "map"
+	case *blueprintpb.Expr_Provider:
+		return "provider"
+	case *blueprintpb.Expr_Resource:
+		return "resource"
+	case nil, *blueprintpb.Expr_Nil:
+		return "nil"
+	case *blueprintpb.Expr_Get:
+		return "get"
+	case *blueprintpb.Expr_ResourceIdentifier:
+		return "identifier"
+	case *blueprintpb.Expr_File:
+		return "file"
+	case *blueprintpb.Expr_GetRuntimeConfig:
+		return "runtime config"
+	case *blueprintpb.Expr_Build:
+		return "build"
+	case *blueprintpb.Expr_Concat:
+		return "concat"
+	case *blueprintpb.Expr_Function:
+		return t.Function.GetName() + "()"
+	case *blueprintpb.Expr_Secret:
+		return "secret"
+	default:
+		return fmt.Sprintf("%T", e.GetType())
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
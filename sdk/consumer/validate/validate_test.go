@@ -0,0 +1,321 @@
+package validate
+
+import (
+	"testing"
+
+	blueprintpb "github.com/alchematik/athanor-go/internal/gen/go/proto/blueprint/v1"
+	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+var schema = &providerpb.Schema{
+	Name: "test",
+	Resources: []*providerpb.ResourceSchema{
+		{
+			Type:       "bucket",
+			Identifier: structSchema("bucket_identifier", map[string]*providerpb.FieldSchema{"name": stringSchema()}),
+			Config: structSchema("bucket_config", map[string]*providerpb.FieldSchema{
+				"region":   immutableSchema(stringSchema()),
+				"password": sensitiveSchema(stringSchema()),
+				"labels":   mapSchema(stringSchema()),
+				"versioning": structSchema("versioning", map[string]*providerpb.FieldSchema{
+					"enabled": boolSchema(),
+				}),
+			}),
+			Attrs: structSchema("bucket_attrs", map[string]*providerpb.FieldSchema{
+				"url":     stringSchema(),
+				"created": boolSchema(),
+			}),
+		},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		stmts []func(sdk.Blueprint) sdk.Blueprint
+		want  string
+	}{
+		{
+			name: "valid",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{
+				resource("a", "bucket", config(nil)),
+				resource("b", "bucket", config(map[string]any{
+					"region":   sdk.GetResource("a").Get("attrs").Get("url"),
+					"password": sdk.Secret{Env: "PASSWORD"},
+				}), sdk.IgnoreChanges("labels.team", "versioning.enabled")),
+				output("url", sdk.GetResource("b").Get("attrs").Get("url")),
+			},
+		},
+		{
+			name:  "unknown resource type",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{resource("a", "queue", config(nil))},
+			want:  `statement 0 (a): unknown resource type "queue"`,
+		},
+		{
+			name:  "unknown field",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{resource("a", "bucket", config(map[string]any{"zone": "a"}))},
+			want:  `statement 0 (a): config: unknown field "zone"`,
+		},
+		{
+			name: "missing field",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{
+				resource("a", "bucket", map[string]any{"region": "us", "password": "pw", "labels": map[string]any{}}),
+			},
+			want: `statement 0 (a): config: missing field "versioning"`,
+		},
+		{
+			name:  "literal type mismatch",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{resource("a", "bucket", config(map[string]any{"region": true}))},
+			want:  `statement 0 (a): config.region: expected string, got bool`,
+		},
+		{
+			name:  "sensitive field mismatch",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{resource("a", "bucket", config(map[string]any{"password": 1}))},
+			want:  `statement 0 (a): config.password: expected string, got int`,
+		},
+		{
+			name: "get of unknown attr",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{
+				resource("a", "bucket", config(nil)),
+				resource("b", "bucket", config(map[string]any{"region": sdk.GetResource("a").Get("attrs").Get("size")})),
+			},
+			want: `statement 1 (b): config.region: reference to "a".attrs.size: "a".attrs has no field "size"`,
+		},
+		{
+			name: "get of wrong kind",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{
+				resource("a", "bucket", config(nil)),
+				resource("b", "bucket", config(map[string]any{"region": sdk.GetResource("a").Get("attrs").Get("created")})),
+			},
+			want: `statement 1 (b): config.region: expected string, got reference to bool`,
+		},
+		{
+			name: "output get",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{
+				resource("a", "bucket", config(nil)),
+				output("size", sdk.GetResource("a").Get("status")),
+			},
+			want: `statement 1: output size: reference to "a".status: resource "bucket" has no field "status"`,
+		},
+		{
+			name: "ignore changes",
+			stmts: []func(sdk.Blueprint) sdk.Blueprint{
+				resource("a", "bucket", config(nil), sdk.IgnoreChanges("zone", "region.name")),
+			},
+			want: `statement 0 (a): ignore_changes: "zone": config has no field "zone"
+statement 0 (a): ignore_changes: "region.name": config.region is a string`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bp, err := sdk.Compile(func(...any) (sdk.Blueprint, error) {
+				b := sdk.Blueprint{}
+				for _, stmt := range tt.stmts {
+					b = stmt(b)
+				}
+
+				return b, nil
+			}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			err = Validate(bp, schema)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("got error:\n%v\nwant:\n%v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckConfigPath(t *testing.T) {
+	config := schema.GetResources()[0].GetConfig()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "region"},
+		{path: "labels.team"},
+		{path: "versioning.enabled"},
+		{path: "zone", want: `"zone": config has no field "zone"`},
+		{path: "versioning.mfa", want: `"versioning.mfa": config.versioning has no field "mfa"`},
+		{path: "password.value", want: `"password.value": config.password is a string`},
+		{path: "labels.team.name", want: `"labels.team.name": config.labels.team is a string`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := checkConfigPath(tt.path, config)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *providerpb.FieldSchema
+	}{
+		{name: "plain", f: stringSchema()},
+		{name: "immutable", f: immutableSchema(stringSchema())},
+		{name: "sensitive", f: sensitiveSchema(stringSchema())},
+		{name: "immutable sensitive", f: immutableSchema(sensitiveSchema(stringSchema()))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unwrap(tt.f); got.GetStringSchema() == nil {
+				t.Errorf("got %s, want the string schema", got)
+			}
+		})
+	}
+}
+
+func TestResolveGet(t *testing.T) {
+	v := &validator{
+		schemas:   []*providerpb.Schema{schema},
+		resources: map[string]*providerpb.ResourceSchema{"a": schema.GetResources()[0]},
+	}
+
+	tests := []struct {
+		name  string
+		names []string
+		want  string
+		err   string
+	}{
+		{name: "resource", names: []string{"a"}},
+		{name: "attr", names: []string{"a", "attrs", "url"}, want: "string"},
+		{name: "immutable config", names: []string{"a", "config", "region"}, want: "string"},
+		{name: "map value", names: []string{"a", "config", "labels", "team"}, want: "string"},
+		{name: "build", names: []string{"site", "outputs", "url"}},
+		{name: "unknown attr", names: []string{"a", "attrs", "size"}, err: `reference to "a".attrs.size: "a".attrs has no field "size"`},
+		{name: "field of string", names: []string{"a", "attrs", "url", "host"}, err: `reference to "a".attrs.url.host: "a".attrs.url is a string and has no fields`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := v.resolveGet(get(tt.names...))
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("got error %v, want %q", err, tt.err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.want == "" {
+				if f != nil {
+					t.Errorf("got %s, want nil", f)
+				}
+
+				return
+			}
+
+			if got := schemaKind(f); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func resource(alias, resourceType string, config any, opts ...sdk.ResourceOption) func(sdk.Blueprint) sdk.Blueprint {
+	return func(b sdk.Blueprint) sdk.Blueprint {
+		return b.WithResource(
+			true,
+			sdk.Provider{Source: sdk.PluginSourceFilePath{Path: "provider"}},
+			sdk.Resource{
+				Identifier: sdk.ResourceIdentifier{
+					Alias:        alias,
+					ResourceType: resourceType,
+					Value:        map[string]any{"name": alias},
+				},
+				Config: config,
+			},
+			opts...,
+		)
+	}
+}
+
+func output(name string, value any) func(sdk.Blueprint) sdk.Blueprint {
+	return func(b sdk.Blueprint) sdk.Blueprint {
+		return b.WithOutput(name, value)
+	}
+}
+
+// config returns a valid bucket config with the entries of overrides applied.
+func config(overrides map[string]any) map[string]any {
+	c := map[string]any{
+		"region":     "us-east1",
+		"password":   "password",
+		"labels":     map[string]any{"team": "storage"},
+		"versioning": map[string]any{"enabled": true},
+	}
+	for k, v := range overrides {
+		c[k] = v
+	}
+
+	return c
+}
+
+func get(names ...string) *blueprintpb.GetExpr {
+	var g *blueprintpb.GetExpr
+	for _, name := range names {
+		var obj *blueprintpb.Expr
+		if g != nil {
+			obj = &blueprintpb.Expr{Type: &blueprintpb.Expr_Get{Get: g}}
+		}
+
+		g = &blueprintpb.GetExpr{Name: name, Object: obj}
+	}
+
+	return g
+}
+
+func stringSchema() *providerpb.FieldSchema {
+	return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_StringSchema{StringSchema: &providerpb.StringSchema{}}}
+}
+
+func boolSchema() *providerpb.FieldSchema {
+	return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_BoolSchema{BoolSchema: &providerpb.BoolSchema{}}}
+}
+
+func mapSchema(value *providerpb.FieldSchema) *providerpb.FieldSchema {
+	return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_MapSchema{MapSchema: &providerpb.MapSchema{Value: value}}}
+}
+
+func structSchema(name string, fields map[string]*providerpb.FieldSchema) *providerpb.FieldSchema {
+	return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_StructSchema{StructSchema: &providerpb.StructSchema{Name: name, Fields: fields}}}
+}
+
+func immutableSchema(value *providerpb.FieldSchema) *providerpb.FieldSchema {
+	return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_ImmutableSchema{ImmutableSchema: &providerpb.ImmutableSchema{Value: value}}}
+}
+
+func sensitiveSchema(value *providerpb.FieldSchema) *providerpb.FieldSchema {
+	return &providerpb.FieldSchema{Type: &providerpb.FieldSchema_SensitiveSchema{SensitiveSchema: &providerpb.SensitiveSchema{Value: value}}}
+}
@@ -3,6 +3,7 @@ package plugin
 import (
 	"context"
 	"errors"
+	"sync"
 
 	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
 	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
@@ -56,10 +57,43 @@ type ResourceHandler interface {
 	Close() error
 }
 
-type ResoureceHandlerInitializer func(context.Context) (ResourceHandler, error)
+// ProviderConfig is the provider configuration from the blueprint, e.g.
+// credentials or a region. Value is nil if the provider wasn't configured.
+type ProviderConfig struct {
+	Value any
+}
+
+type ResoureceHandlerInitializer func(context.Context, ProviderConfig) (ResourceHandler, error)
 
 type server struct {
 	resourceHandlers map[string]ResoureceHandlerInitializer
+
+	mu     sync.RWMutex
+	config ProviderConfig
+}
+
+func (s *server) Configure(ctx context.Context, req *providerpb.ConfigureRequest) (*providerpb.ConfigureResponse, error) {
+	var config any
+	if req.GetConfig() != nil {
+		var err error
+		config, err = value.ParseProto(req.GetConfig())
+		if err != nil {
+			return &providerpb.ConfigureResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	s.mu.Lock()
+	s.config = ProviderConfig{Value: config}
+	s.mu.Unlock()
+
+	return &providerpb.ConfigureResponse{}, nil
+}
+
+func (s *server) providerConfig() ProviderConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.config
 }
 
 func (s *server) GetResource(ctx context.Context, req *providerpb.GetResourceRequest) (*providerpb.GetResourceResponse, error) {
@@ -69,7 +103,7 @@ func (s *server) GetResource(ctx context.Context, req *providerpb.GetResourceReq
 		return &providerpb.GetResourceResponse{}, status.Error(codes.NotFound, "resource type not found")
 	}
 
-	handler, err := initializer(ctx)
+	handler, err := initializer(ctx, s.providerConfig())
 	if err != nil {
 		return &providerpb.GetResourceResponse{}, status.Error(codes.Internal, err.Error())
 	}
@@ -105,7 +139,7 @@ func (s *server) CreateResource(ctx context.Context, req *providerpb.CreateResou
 		return &providerpb.CreateResourceResponse{}, status.Error(codes.NotFound, "resource type not found")
 	}
 
-	handler, err := initializer(ctx)
+	handler, err := initializer(ctx, s.providerConfig())
 	if err != nil {
 		return &providerpb.CreateResourceResponse{}, status.Error(codes.Internal, err.Error())
 	}
@@ -141,7 +175,7 @@ func (s *server) UpdateResource(ctx context.Context, req *providerpb.UpdateResou
 		return &providerpb.UpdateResourceResponse{}, status.Error(codes.NotFound, "resource type not found")
 	}
 
-	handler, err := initializer(ctx)
+	handler, err := initializer(ctx, s.providerConfig())
 	if err != nil {
 		return &providerpb.UpdateResourceResponse{}, status.Error(codes.Internal, err.Error())
 	}
@@ -201,7 +235,7 @@ func (s *server) DeleteResource(ctx context.Context, req *providerpb.DeleteResou
 		return &providerpb.DeleteResourceResponse{}, status.Error(codes.NotFound, "resource type not found")
 	}
 
-	handler, err := initializer(ctx)
+	handler, err := initializer(ctx, s.providerConfig())
 	if err != nil {
 		return &providerpb.DeleteResourceResponse{}, status.Error(codes.Internal, err.Error())
 	}
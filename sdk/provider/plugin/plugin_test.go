@@ -0,0 +1,100 @@
+package plugin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testHandler struct{}
+
+func (testHandler) GetResource(_ context.Context, id value.Identifier) (value.Resource, error) {
+	return value.Resource{Identifier: id}, nil
+}
+
+func (testHandler) CreateResource(_ context.Context, id value.Identifier, config any) (value.Resource, error) {
+	return value.Resource{Identifier: id, Config: config}, nil
+}
+
+func (testHandler) UpdateResource(_ context.Context, id value.Identifier, config any, _ []value.UpdateMaskField) (value.Resource, error) {
+	return value.Resource{Identifier: id, Config: config}, nil
+}
+
+func (testHandler) DeleteResource(context.Context, value.Identifier) error {
+	return nil
+}
+
+func (testHandler) Close() error {
+	return nil
+}
+
+func TestConfigure(t *testing.T) {
+	region := &providerpb.Value{
+		Type: &providerpb.Value_Map{
+			Map: &providerpb.MapValue{
+				Entries: map[string]*providerpb.Value{
+					"region": {Type: &providerpb.Value_StringValue{StringValue: "us-east1"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		config *providerpb.Value
+		want   ProviderConfig
+		code   codes.Code
+	}{
+		{name: "unconfigured", want: ProviderConfig{}},
+		{name: "config", config: region, want: ProviderConfig{Value: map[string]any{"region": "us-east1"}}},
+		{name: "invalid config", config: &providerpb.Value{}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ProviderConfig
+			s := &server{
+				resourceHandlers: map[string]ResoureceHandlerInitializer{
+					"bucket": func(_ context.Context, config ProviderConfig) (ResourceHandler, error) {
+						got = config
+						return testHandler{}, nil
+					},
+				},
+			}
+
+			_, err := s.Configure(context.Background(), &providerpb.ConfigureRequest{Config: tt.config})
+			if status.Code(err) != tt.code {
+				t.Fatalf("got error %v, want code %s", err, tt.code)
+			}
+
+			if err != nil {
+				return
+			}
+
+			// Handlers are initialized with the config on each request.
+			_, err = s.GetResource(context.Background(), &providerpb.GetResourceRequest{
+				Identifier: &providerpb.Value{
+					Type: &providerpb.Value_Identifier{
+						Identifier: &providerpb.Identifier{
+							Type:  "bucket",
+							Value: &providerpb.Value{Type: &providerpb.Value_StringValue{StringValue: "b"}},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got config %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,11 +8,6 @@ import (
 func Build(s Schema) error {
 	outputPath := os.Args[1]
 
-	f, err := os.OpenFile(outputPath, os.O_APPEND|os.O_WRONLY, os.ModeAppend)
-	if err != nil {
-		return err
-	}
-
 	p, err := s.ToProto()
 	if err != nil {
 		return err
@@ -23,6 +18,5 @@ func Build(s Schema) error {
 		return err
 	}
 
-	_, err = f.Write(data)
-	return err
+	return os.WriteFile(outputPath, data, 0666)
 }
@@ -92,6 +92,12 @@ func Immutable(value FieldSchema) ImmutableSchema {
 	return ImmutableSchema{Value: value}
 }
 
+// Sensitive marks value as sensitive. Sensitive values are redacted when
+// printed or logged by the SDK.
+func Sensitive(value FieldSchema) SensitiveSchema {
+	return SensitiveSchema{Value: value}
+}
+
 func Struct(name string, fields map[string]FieldSchema) StructSchema {
 	return StructSchema{
 		Name:   name,
@@ -142,6 +148,12 @@ type ImmutableSchema struct {
 	Value FieldSchema
 }
 
+type SensitiveSchema struct {
+	FieldSchema
+
+	Value FieldSchema
+}
+
 func FieldSchemaToProto(f FieldSchema) (*providerpb.FieldSchema, error) {
 	switch val := f.(type) {
 	case StringSchema:
@@ -225,6 +237,19 @@ func FieldSchemaToProto(f FieldSchema) (*providerpb.FieldSchema, error) {
 				},
 			},
 		}, nil
+	case SensitiveSchema:
+		value, err := FieldSchemaToProto(val.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &providerpb.FieldSchema{
+			Type: &providerpb.FieldSchema_SensitiveSchema{
+				SensitiveSchema: &providerpb.SensitiveSchema{
+					Value: value,
+				},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid type for schema: %T", f)
 	}
@@ -1,7 +1,9 @@
 package value
 
 import (
+	"encoding/json"
 	"fmt"
+
 	providerpb "github.com/alchematik/athanor-go/internal/gen/go/proto/provider/v1"
 )
 
@@ -125,6 +127,13 @@ func ParseProto(val *providerpb.Value) (any, error) {
 			}
 		}
 		return list, nil
+	case *providerpb.Value_Sensitive:
+		val, err := ParseProto(v.Sensitive.GetValue())
+		if err != nil {
+			return nil, err
+		}
+
+		return Sensitive{Value: val}, nil
 	case *providerpb.Value_Nil:
 		return nil, nil
 	default:
@@ -164,6 +173,43 @@ type Immutable struct {
 	Value any
 }
 
+// Sensitive is a value that must not be displayed. It is redacted when
+// formatted with fmt, including %#v, and when encoded as JSON.
+type Sensitive struct {
+	Value any
+}
+
+const redacted = "<sensitive>"
+
+func (s Sensitive) String() string {
+	return redacted
+}
+
+func (s Sensitive) GoString() string {
+	return "value.Sensitive{" + redacted + "}"
+}
+
+func (s Sensitive) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+// ParseSensitive returns a func that parses a sensitive value with parse. The
+// value may be wrapped in Sensitive or not.
+func ParseSensitive[T any](parse func(any) (T, error)) func(any) (Sensitive, error) {
+	return func(val any) (Sensitive, error) {
+		if s, ok := val.(Sensitive); ok {
+			val = s.Value
+		}
+
+		v, err := parse(val)
+		if err != nil {
+			return Sensitive{}, fmt.Errorf("error parsing sensitive value: %w", err)
+		}
+
+		return Sensitive{Value: v}, nil
+	}
+}
+
 type Operation string
 
 const (
@@ -245,6 +291,18 @@ func ToImmutableType(subTypeConvertFunc func(any) any) func(any) Immutable {
 	}
 }
 
+// ToSensitiveType returns a func that converts the value wrapped by a
+// Sensitive with subTypeConvertFunc, keeping it wrapped.
+func ToSensitiveType(subTypeConvertFunc func(any) any) func(any) Sensitive {
+	return func(val any) Sensitive {
+		if s, ok := val.(Sensitive); ok {
+			val = s.Value
+		}
+
+		return Sensitive{Value: subTypeConvertFunc(val)}
+	}
+}
+
 func ToValueProto(val any) (*providerpb.Value, error) {
 	switch v := val.(type) {
 	case string:
@@ -329,6 +387,19 @@ func ToValueProto(val any) (*providerpb.Value, error) {
 				},
 			},
 		}, nil
+	case Sensitive:
+		value, err := ToValueProto(convert(v.Value))
+		if err != nil {
+			return nil, err
+		}
+
+		return &providerpb.Value{
+			Type: &providerpb.Value_Sensitive{
+				Sensitive: &providerpb.Sensitive{
+					Value: value,
+				},
+			},
+		}, nil
 	case nil:
 		return &providerpb.Value{
 			Type: &providerpb.Value_Nil{},
@@ -0,0 +1,93 @@
+package value
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSensitiveRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(any) (Sensitive, error)
+		convert func(any) Sensitive
+		value   any
+	}{
+		{
+			name:    "string",
+			parse:   ParseSensitive(String),
+			convert: ToSensitiveType(ToType[any]),
+			value:   "hunter2",
+		},
+		{
+			name:    "map",
+			parse:   ParseSensitive(Map[string]),
+			convert: ToSensitiveType(ToType[string]),
+			value:   map[string]any{"user": "admin", "password": "hunter2"},
+		},
+		{
+			name:    "list",
+			parse:   ParseSensitive(List[string]),
+			convert: ToSensitiveType(ToType[string]),
+			value:   []any{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, err := ToValueProto(Sensitive{Value: tt.value})
+			if err != nil {
+				t.Fatalf("error converting value to proto: %v", err)
+			}
+
+			parsed, err := ParseProto(in)
+			if err != nil {
+				t.Fatalf("error parsing proto: %v", err)
+			}
+
+			s, err := tt.parse(parsed)
+			if err != nil {
+				t.Fatalf("error parsing sensitive value: %v", err)
+			}
+
+			out, err := ToValueProto(tt.convert(s))
+			if err != nil {
+				t.Fatalf("error converting parsed value to proto: %v", err)
+			}
+
+			if out.GetSensitive() == nil {
+				t.Fatalf("got %v, want a sensitive value", out)
+			}
+
+			got, err := ParseProto(out)
+			if err != nil {
+				t.Fatalf("error parsing proto: %v", err)
+			}
+
+			if want := (Sensitive{Value: tt.value}); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %#v, want %#v", got.(Sensitive).Value, want.Value)
+			}
+		})
+	}
+}
+
+func TestSensitiveRedacted(t *testing.T) {
+	s := Sensitive{Value: "hunter2"}
+
+	for _, got := range []string{fmt.Sprint(s), fmt.Sprintf("%#v", s)} {
+		if strings.Contains(got, "hunter2") {
+			t.Errorf("got %q, want the value redacted", got)
+		}
+	}
+
+	data, err := json.Marshal(map[string]any{"password": s})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(string(data), "hunter2") {
+		t.Errorf("got %s, want the value redacted", data)
+	}
+}